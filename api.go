@@ -0,0 +1,32 @@
+package foursquarego
+
+import "net/http"
+
+// VenueAPI is the set of methods of VenueService. Client holds services as
+// interfaces so code depending on them can be tested with the mocks in
+// foursquaremock instead of an HTTP server. Future services get an
+// interface of their own named the same way.
+type VenueAPI interface {
+	SetHeader(key, value string) VenueAPI
+	WithResult(r *Result) VenueAPI
+
+	Details(id string) (*Venue, *http.Response, error)
+	Categories() ([]Category, *http.Response, error)
+	Search(params *VenueSearchParams) ([]Venue, *http.Response, error)
+	SuggestCompletion(params *VenueSuggestParams) ([]MiniVenue, *http.Response, error)
+	Trending(params *VenueTrendingParams) ([]Venue, *http.Response, error)
+	Explore(params *VenueExploreParams) (*VenueExploreResp, *http.Response, error)
+	Sweep(params *SweepParams) ([]Venue, *SweepCheckpoint, error)
+
+	Photos(params *VenuePhotosParams) (*PhotoGrouping, *http.Response, error)
+	Events(id string) (*Events, *http.Response, error)
+	Hours(id string) (*VenueHoursResp, *http.Response, error)
+	Likes(id string) (*LikesResp, *http.Response, error)
+	Links(id string) (*Links, *http.Response, error)
+	Listed(params *VenueListedParams) (*Listed, *http.Response, error)
+	NextVenues(id string) ([]Venue, *http.Response, error)
+	Menu(id string) (*MenuResp, *http.Response, error)
+	Tips(params *VenueTipsParams) ([]Tip, *http.Response, error)
+}
+
+var _ VenueAPI = (*VenueService)(nil)
@@ -0,0 +1,240 @@
+// Package cassette records real request/response pairs to a file and
+// replays them later so integration tests run without the network.
+//
+//	rec, err := cassette.New("json/cassettes/details.json", cassette.Replay, nil)
+//	defer rec.Stop()
+//	client := foursquarego.NewClient(rec.Client(), "foursquare", clientID, clientSecret, "")
+//
+// Credentials are scrubbed from recorded queries. Requests are matched on
+// the method, path and the query sorted by key, so a cassette recorded with
+// real credentials replays with fake ones.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode is what a Recorder does with requests.
+type Mode int
+
+// Options for Mode
+const (
+	// Replay serves requests from the cassette and never uses the network.
+	Replay Mode = iota
+	// Record sends requests to the transport and saves them on Stop,
+	// replacing the cassette.
+	Record
+)
+
+// Scrubbed is put in place of the credentials in recorded queries.
+const Scrubbed = "SCRUBBED"
+
+// scrubbedParams are the query parameters holding credentials. client_id
+// is not secret but is scrubbed so cassettes replay with any app.
+var scrubbedParams = []string{"client_id", "client_secret", "access_token"}
+
+// Request is the recorded part of a request.
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// Interaction is a request and the response it got.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the file format, interactions are in the order they were
+// recorded.
+type Cassette struct {
+	// Synthetic marks a cassette made against a fake server rather than
+	// recorded from the real API. It only shows the client decodes its own
+	// fixtures and cannot catch changes in the API. Recording again clears
+	// it.
+	Synthetic    bool          `json:"synthetic,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records or replays a cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New creates a Recorder for the cassette at path. In Replay mode the
+// cassette must exist. transport is used in Record mode, nil means
+// http.DefaultTransport.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, transport: transport}
+
+	if mode == Replay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: %s: %v", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the Recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Synthetic reports whether the cassette was not recorded from the real
+// API, see Cassette.Synthetic.
+func (r *Recorder) Synthetic() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Synthetic
+}
+
+// Client returns an http.Client using the Recorder, pass it to
+// foursquarego.NewClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := NewRequest(req)
+	if r.mode == Replay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(body),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = ioutil.NopCloser(strings.NewReader(string(body)))
+	return resp, nil
+}
+
+// replay serves the first unused interaction matching the request. Once
+// all matching interactions are used the last one is served again.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for i, in := range r.cassette.Interactions {
+		if in.Request != recorded {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("cassette: %s has no interaction for %s %s?%s", r.path, recorded.Method, recorded.Path, recorded.Query)
+	}
+	r.used[match] = true
+
+	in := r.cassette.Interactions[match]
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+		StatusCode:    in.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        in.Response.Header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(in.Response.Body)),
+		ContentLength: int64(len(in.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Stop saves the cassette in Record mode. It does nothing in Replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	r.mu.Lock()
+	err := enc.Encode(r.cassette)
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b.Bytes(), 0644)
+}
+
+// NewRequest is the recorded form of req with credentials scrubbed and
+// the query sorted by key.
+func NewRequest(req *http.Request) Request {
+	return Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  normalizeQuery(req.URL.Query()),
+	}
+}
+
+func normalizeQuery(q url.Values) string {
+	for _, k := range scrubbedParams {
+		if q.Get(k) != "" {
+			q.Set(k, Scrubbed)
+		}
+	}
+	// Encode sorts by key.
+	return q.Encode()
+}
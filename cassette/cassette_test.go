@@ -0,0 +1,74 @@
+package cassette
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Write([]byte(r.URL.Path + " " + strings.Repeat("!", count)))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "cassette")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassettes", "test.json")
+
+	rec, err := New(path, Record, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "/v2/venues/a !", get(t, rec, server.URL+"/v2/venues/a?v=1&client_id=id&client_secret=hunter2"))
+	assert.Equal(t, "/v2/venues/a !!", get(t, rec, server.URL+"/v2/venues/a?client_secret=hunter2&client_id=id&v=1"))
+	assert.Nil(t, rec.Stop())
+
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "hunter2")
+	assert.Contains(t, string(b), "client_secret="+Scrubbed)
+
+	rec, err = New(path, Replay, nil)
+	assert.Nil(t, err)
+	assert.False(t, rec.Synthetic())
+	assert.Equal(t, "/v2/venues/a !", get(t, rec, "https://api.foursquare.com/v2/venues/a?client_id=other&client_secret=other&v=1"))
+	assert.Equal(t, "/v2/venues/a !!", get(t, rec, "https://api.foursquare.com/v2/venues/a?v=1&client_id=other&client_secret=other"))
+	assert.Equal(t, "/v2/venues/a !!", get(t, rec, "https://api.foursquare.com/v2/venues/a?v=1&client_id=other&client_secret=other"))
+	assert.Equal(t, 2, count)
+
+	_, err = rec.Client().Get("https://api.foursquare.com/v2/venues/a?v=2")
+	assert.Error(t, err)
+	_, err = rec.Client().Get("https://api.foursquare.com/v2/venues/b?v=1&client_id=other&client_secret=other")
+	assert.Error(t, err)
+}
+
+func TestNew_missingCassette(t *testing.T) {
+	_, err := New(filepath.Join("testdata", "missing.json"), Replay, nil)
+	assert.Error(t, err)
+}
+
+func get(t *testing.T, rec *Recorder, url string) string {
+	resp, err := rec.Client().Get(url)
+	if !assert.Nil(t, err) {
+		return ""
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, "4999", resp.Header.Get("X-RateLimit-Remaining"))
+	b, _ := ioutil.ReadAll(resp.Body)
+	return string(b)
+}
+
+func TestRecorder_Synthetic(t *testing.T) {
+	rec, err := New(filepath.Join("..", "json", "cassettes", "venues.json"), Replay, nil)
+	assert.Nil(t, err)
+	assert.True(t, rec.Synthetic())
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/cassette"
+)
+
+// drift reports the fields in response files that the structs do not
+// decode. Files are either a single response envelope, which needs
+// -endpoint, or a cassette whose endpoints come from the request paths.
+func (c *command) drift(args []string) error {
+	fs, format := c.flags("drift", "FILE...")
+	endpoint := fs.String("endpoint", "", "endpoint of response files, ex venues.details")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	var drift []fileDrift
+	for _, file := range fs.Args() {
+		found, err := checkFile(file, *endpoint)
+		if err != nil {
+			return err
+		}
+		drift = append(drift, found...)
+	}
+
+	out := output{value: drift, header: []string{"file", "endpoint", "path"}}
+	for _, d := range drift {
+		out.rows = append(out.rows, []string{d.File, d.Endpoint, d.Path})
+	}
+	if err := out.write(c.stdout, *format); err != nil {
+		return err
+	}
+	if len(drift) > 0 {
+		return fmt.Errorf("foursquare: %d fields are not decoded", len(drift))
+	}
+	return nil
+}
+
+type fileDrift struct {
+	File string `json:"file"`
+	foursquarego.Drift
+}
+
+func checkFile(file, endpoint string) ([]fileDrift, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var recorded cassette.Cassette
+	if err := json.Unmarshal(b, &recorded); err != nil {
+		return nil, fmt.Errorf("foursquare: %s: %v", file, err)
+	}
+
+	var drift []fileDrift
+	check := func(endpoint string, body []byte) error {
+		found, err := foursquarego.CheckDrift(endpoint, body)
+		if err != nil {
+			return fmt.Errorf("foursquare: %s: %v", file, err)
+		}
+		for _, d := range found {
+			drift = append(drift, fileDrift{File: file, Drift: d})
+		}
+		return nil
+	}
+
+	if recorded.Interactions == nil {
+		if endpoint == "" {
+			return nil, fmt.Errorf("foursquare: %s is not a cassette, set -endpoint", file)
+		}
+		return drift, check(endpoint, b)
+	}
+
+	seen := map[foursquarego.Drift]bool{}
+	for _, in := range recorded.Interactions {
+		endpoint, _ := foursquarego.EndpointForPath(in.Request.Path)
+		if endpoint == "" {
+			continue
+		}
+		before := len(drift)
+		if err := check(endpoint, []byte(in.Response.Body)); err != nil {
+			return nil, err
+		}
+		// The same endpoint is often recorded more than once.
+		found := drift[:before]
+		for _, d := range drift[before:] {
+			if !seen[d.Drift] {
+				seen[d.Drift] = true
+				found = append(found, d)
+			}
+		}
+		drift = found
+	}
+	return drift, nil
+}
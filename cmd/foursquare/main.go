@@ -0,0 +1,219 @@
+// Command foursquare makes Foursquare API requests from the command line.
+//
+// Credentials are read from FOURSQUARE_CLIENT_ID, FOURSQUARE_CLIENT_SECRET
+// and FOURSQUARE_ACCESS_TOKEN. FOURSQUARE_MODE defaults to foursquare.
+//
+// Usage:
+//
+//	foursquare venue details [-o table|json|csv] VENUE_ID
+//	foursquare venue search [flags] -ll 40.7,-74 -query coffee
+//	foursquare venue explore [flags] -near "Chicago, IL"
+//	foursquare venue photos [flags] VENUE_ID
+//	foursquare venue tips [flags] VENUE_ID
+//	foursquare categories [-o table|json|csv]
+//	foursquare raw PATH
+//	foursquare drift [-endpoint NAME] FILE...
+//
+// Run a command with -h to see its flags.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/peppage/foursquarego"
+)
+
+const usage = `usage: foursquare <command> [flags] [args]
+
+commands:
+  venue details VENUE_ID   details for a venue
+  venue search             search for venues
+  venue explore            recommended venues
+  venue photos VENUE_ID    photos of a venue
+  venue tips VENUE_ID      tips on a venue
+  categories               all venue categories
+  raw PATH                 any GET request, ex venues/trending?ll=40.7,-74
+  drift FILE...            fields in responses or cassettes the structs miss
+
+credentials are read from FOURSQUARE_CLIENT_ID, FOURSQUARE_CLIENT_SECRET
+and FOURSQUARE_ACCESS_TOKEN
+`
+
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdout, os.Stderr, http.DefaultClient))
+}
+
+// run is main with its dependencies passed in so it can be tested.
+func run(args []string, getenv func(string) string, stdout, stderr io.Writer, httpClient *http.Client) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	case "drift":
+		// Reads files only, no credentials needed.
+		cmd := &command{stdout: stdout, stderr: stderr}
+		return exitCode(cmd.drift(args[1:]), stderr)
+	}
+
+	clientID := getenv("FOURSQUARE_CLIENT_ID")
+	if clientID == "" {
+		fmt.Fprintln(stderr, "foursquare: FOURSQUARE_CLIENT_ID is not set")
+		return 1
+	}
+	mode := getenv("FOURSQUARE_MODE")
+	if mode == "" {
+		mode = "foursquare"
+	}
+	client := foursquarego.NewClient(httpClient, mode, clientID,
+		getenv("FOURSQUARE_CLIENT_SECRET"), getenv("FOURSQUARE_ACCESS_TOKEN"))
+
+	cmd := &command{client: client, stdout: stdout, stderr: stderr}
+
+	var err error
+	switch args[0] {
+	case "venue":
+		err = cmd.venue(args[1:])
+	case "categories":
+		err = cmd.categories(args[1:])
+	case "raw":
+		err = cmd.raw(args[1:])
+	default:
+		fmt.Fprintf(stderr, "foursquare: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+	return exitCode(err, stderr)
+}
+
+// exitCode prints the error of a command and returns the exit status.
+func exitCode(err error, stderr io.Writer) int {
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err == errUsage {
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+type command struct {
+	client *foursquarego.Client
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// flags returns a FlagSet with the -o output flag.
+func (c *command) flags(name, args string) (*flag.FlagSet, *string) {
+	fs := c.flagSet(name, args)
+	format := fs.String("o", "table", "output format: table, json or csv")
+	return fs, format
+}
+
+// flagSet returns a FlagSet printing its usage to stderr.
+func (c *command) flagSet(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(c.stderr, strings.TrimSpace("usage: foursquare "+name+" [flags] "+args))
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses the flags and checks the number of positional arguments.
+func (c *command) parse(fs *flag.FlagSet, args []string, nargs int) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != nargs {
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+func (c *command) categories(args []string) error {
+	fs, format := c.flags("categories", "")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	categories, _, err := c.client.Venues.Categories()
+	if err != nil {
+		return err
+	}
+
+	out := output{value: categories, header: []string{"id", "name", "parent"}}
+	var walk func(parent string, cats []foursquarego.Category)
+	walk = func(parent string, cats []foursquarego.Category) {
+		for _, cat := range cats {
+			out.rows = append(out.rows, []string{cat.ID, cat.Name, parent})
+			walk(cat.Name, cat.Categories)
+		}
+	}
+	walk("", categories)
+	return out.write(c.stdout, *format)
+}
+
+// raw always writes json, the response has no known shape for a table.
+func (c *command) raw(args []string) error {
+	fs := c.flagSet("raw", "PATH")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	response, _, err := c.client.RawRequest(strings.TrimPrefix(fs.Arg(0), "/"))
+	if err != nil {
+		return err
+	}
+	return output{value: response}.write(c.stdout, "json")
+}
+
+// output is the result of a command, value is written as json and the
+// header and rows as a table or csv.
+type output struct {
+	value  interface{}
+	header []string
+	rows   [][]string
+}
+
+func (o output) write(w io.Writer, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(o.value)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(o.header)
+		cw.WriteAll(o.rows)
+		return cw.Error()
+	case "table":
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(o.header, "\t")))
+		for _, row := range o.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("foursquare: unknown output format %q", format)
+}
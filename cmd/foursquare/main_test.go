@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/stretchr/testify/assert"
+)
+
+var testEnv = map[string]string{
+	"FOURSQUARE_CLIENT_ID":     "ci",
+	"FOURSQUARE_CLIENT_SECRET": "cs",
+}
+
+// testRun runs the command against a server serving the fixture.
+func testRun(t *testing.T, path, fixture string, args ...string) (int, string, *http.Request) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var req *http.Request
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		b, err := ioutil.ReadFile("../../json/venues/" + fixture)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", fixture)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	serverURL, _ := url.Parse(server.URL)
+	httpClient := &http.Client{Transport: foursquarego.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r.URL.Scheme = serverURL.Scheme
+		r.URL.Host = serverURL.Host
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+
+	var stdout, stderr bytes.Buffer
+	code := run(args, func(k string) string { return testEnv[k] }, &stdout, &stderr, httpClient)
+	if code != 0 {
+		t.Log(stderr.String())
+	}
+	return code, stdout.String(), req
+}
+
+func TestVenueSearch(t *testing.T) {
+	code, out, req := testRun(t, "/v2/venues/search", "search.json",
+		"venue", "search", "-ll", "40.7,-74", "-query", "singlecut", "-o", "csv", "-columns", "id,name")
+	assert.Equal(t, 0, code)
+
+	assert.Equal(t, "40.7,-74", req.URL.Query().Get("ll"))
+	assert.Equal(t, "singlecut", req.URL.Query().Get("query"))
+	assert.Equal(t, "cs", req.URL.Query().Get("client_secret"))
+
+	rows, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id", "name"}, rows[0])
+	assert.Equal(t, "4f68de6bd5fbee32e5f4f3a5", rows[1][0])
+}
+
+func TestVenueDetails(t *testing.T) {
+	code, out, _ := testRun(t, "/v2/venues/5414d0a6498ea3d31a3c64cf", "details.json",
+		"venue", "details", "-o", "json", "5414d0a6498ea3d31a3c64cf")
+	assert.Equal(t, 0, code)
+
+	var venue struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(out), &venue))
+	assert.Equal(t, "Threes Brewing", venue.Name)
+}
+
+func TestCategories(t *testing.T) {
+	code, out, _ := testRun(t, "/v2/venues/categories", "categories.json", "categories")
+	assert.Equal(t, 0, code)
+
+	lines := strings.Split(out, "\n")
+	assert.True(t, strings.HasPrefix(lines[0], "ID"))
+	assert.Contains(t, out, "Amphitheater")
+}
+
+func TestRaw(t *testing.T) {
+	code, out, req := testRun(t, "/v2/venues/trending", "trending.json", "raw", "venues/trending?ll=40.7,-74")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "40.7,-74", req.URL.Query().Get("ll"))
+	assert.Contains(t, out, `"requestId"`)
+}
+
+func TestRaw_noOutputFlag(t *testing.T) {
+	code, _, req := testRun(t, "/v2/venues/trending", "trending.json", "raw", "-o", "csv", "venues/trending")
+	assert.Equal(t, 1, code)
+	assert.Nil(t, req)
+}
+
+func TestHelp_noCredentials(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"help"}, func(string) string { return "" }, &stdout, &stderr, nil)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, usage, stdout.String())
+	assert.Empty(t, stderr.String())
+}
+
+func TestUsage(t *testing.T) {
+	code, _, _ := testRun(t, "/", "details.json", "venue", "details")
+	assert.Equal(t, 2, code)
+
+	code, _, _ = testRun(t, "/", "details.json", "venue", "search", "-query", "coffee")
+	assert.Equal(t, 1, code)
+}
+
+func TestDrift(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"drift", "-endpoint", "venues.likes", "-o", "csv", "../../json/venues/likes.json"},
+		func(string) string { return "" }, &stdout, &stderr, nil)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "file,endpoint,path\n../../json/venues/likes.json,venues.likes,response.like\n", stdout.String())
+	assert.Contains(t, stderr.String(), "1 fields are not decoded")
+
+	stdout.Reset()
+	code = run([]string{"drift", "-o", "csv", "../../json/cassettes/venues.json"},
+		func(string) string { return "" }, &stdout, &stderr, nil)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "file,endpoint,path\n", stdout.String())
+
+	code = run([]string{"drift", "../../json/venues/likes.json"}, func(string) string { return "" }, &stdout, &stderr, nil)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "set -endpoint")
+}
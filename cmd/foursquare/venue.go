@@ -0,0 +1,250 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/export"
+)
+
+const defaultColumns = "id,name,category,address,city,lat,lng,rating"
+
+func (c *command) venue(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return errUsage
+	}
+
+	switch args[0] {
+	case "details":
+		return c.venueDetails(args[1:])
+	case "search":
+		return c.venueSearch(args[1:])
+	case "explore":
+		return c.venueExplore(args[1:])
+	case "photos":
+		return c.venuePhotos(args[1:])
+	case "tips":
+		return c.venueTips(args[1:])
+	}
+	fmt.Fprintf(c.stderr, "foursquare: unknown venue command %q\n\n%s", args[0], usage)
+	return errUsage
+}
+
+func (c *command) venueDetails(args []string) error {
+	fs, format := c.flags("venue details", "VENUE_ID")
+	columns := fs.String("columns", defaultColumns, "columns for table and csv output")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	venue, _, err := c.client.Venues.Details(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	out, err := venueOutput(venue, []foursquarego.Venue{*venue}, *columns)
+	if err != nil {
+		return err
+	}
+	return out.write(c.stdout, *format)
+}
+
+func (c *command) venueSearch(args []string) error {
+	fs, format := c.flags("venue search", "")
+	columns := fs.String("columns", defaultColumns, "columns for table and csv output")
+	params := new(foursquarego.VenueSearchParams)
+	var intent, categories string
+	fs.StringVar(&params.LatLong, "ll", "", "latitude and longitude, ex 40.7,-74")
+	fs.StringVar(&params.Near, "near", "", "a place name, ex \"Chicago, IL\"")
+	fs.StringVar(&params.Query, "query", "", "search term")
+	fs.IntVar(&params.Limit, "limit", 0, "number of results, up to 50")
+	fs.StringVar(&intent, "intent", "", "checkin, browse, global or match")
+	fs.IntVar(&params.Radius, "radius", 0, "radius in meters")
+	fs.StringVar(&params.Sw, "sw", "", "south west corner with -ne, ex 40.7,-74.1")
+	fs.StringVar(&params.Ne, "ne", "", "north east corner with -sw, ex 40.8,-73.9")
+	fs.StringVar(&categories, "categories", "", "comma separated category ids")
+	fs.StringVar(&params.URL, "url", "", "a third party URL of the venue")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	params.Intent = foursquarego.SearchIntent(intent)
+	params.CategoryID = splitList(categories)
+
+	venues, _, err := c.client.Venues.Search(params)
+	if err != nil {
+		return err
+	}
+
+	out, err := venueOutput(venues, venues, *columns)
+	if err != nil {
+		return err
+	}
+	return out.write(c.stdout, *format)
+}
+
+func (c *command) venueExplore(args []string) error {
+	fs, format := c.flags("venue explore", "")
+	columns := fs.String("columns", defaultColumns, "columns for table and csv output")
+	params := new(foursquarego.VenueExploreParams)
+	var section, categories, prices string
+	var openNow, sortByDistance bool
+	fs.StringVar(&params.LatLong, "ll", "", "latitude and longitude, ex 40.7,-74")
+	fs.StringVar(&params.Near, "near", "", "a place name, ex \"Chicago, IL\"")
+	fs.StringVar(&section, "section", "", "food, drink, coffee, shops, arts, outdoors, sights, trending or topPicks")
+	fs.StringVar(&params.Query, "query", "", "search term")
+	fs.IntVar(&params.Limit, "limit", 0, "number of results, up to 50")
+	fs.IntVar(&params.Offset, "offset", 0, "offset for paging")
+	fs.IntVar(&params.Radius, "radius", 0, "radius in meters")
+	fs.StringVar(&categories, "categories", "", "comma separated category ids")
+	fs.StringVar(&prices, "price", "", "comma separated price tiers, ex 1,2")
+	fs.BoolVar(&openNow, "open-now", false, "only venues open now")
+	fs.BoolVar(&sortByDistance, "sort-by-distance", false, "sort by distance instead of relevance")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	params.Section = foursquarego.ExploreSection(section)
+	params.CategoryID = splitList(categories)
+	for _, p := range splitList(prices) {
+		tier, err := strconv.Atoi(p)
+		if err != nil {
+			return fmt.Errorf("foursquare: invalid price %q", p)
+		}
+		params.Price = append(params.Price, tier)
+	}
+	if openNow {
+		params.OpenNow = foursquarego.True
+	}
+	if sortByDistance {
+		params.SortByDistance = foursquarego.True
+	}
+
+	resp, _, err := c.client.Venues.Explore(params)
+	if err != nil {
+		return err
+	}
+
+	var venues []foursquarego.Venue
+	for _, group := range resp.Groups {
+		for _, item := range group.Items {
+			venues = append(venues, item.Venue)
+		}
+	}
+
+	out, err := venueOutput(resp, venues, *columns)
+	if err != nil {
+		return err
+	}
+	return out.write(c.stdout, *format)
+}
+
+func (c *command) venuePhotos(args []string) error {
+	fs, format := c.flags("venue photos", "VENUE_ID")
+	params := new(foursquarego.VenuePhotosParams)
+	var group string
+	fs.StringVar(&group, "group", "", "venue or checkin")
+	fs.IntVar(&params.Limit, "limit", 0, "number of results, up to 200")
+	fs.IntVar(&params.Offset, "offset", 0, "offset for paging")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+	params.VenueID = fs.Arg(0)
+	params.Group = foursquarego.PhotoGroup(group)
+
+	photos, _, err := c.client.Venues.Photos(params)
+	if err != nil {
+		return err
+	}
+
+	out := output{
+		value:  photos,
+		header: []string{"id", "createdAt", "width", "height", "user", "url"},
+	}
+	for _, p := range photos.Items {
+		out.rows = append(out.rows, []string{
+			p.ID,
+			formatTime(p.CreatedAt),
+			strconv.Itoa(p.Width),
+			strconv.Itoa(p.Height),
+			strings.TrimSpace(p.User.FirstName + " " + p.User.LastName),
+			p.URL(foursquarego.PhotoOriginal),
+		})
+	}
+	return out.write(c.stdout, *format)
+}
+
+func (c *command) venueTips(args []string) error {
+	fs, format := c.flags("venue tips", "VENUE_ID")
+	params := new(foursquarego.VenueTipsParams)
+	var sort string
+	fs.StringVar(&sort, "sort", "", "friends, recent or popular")
+	fs.IntVar(&params.Limit, "limit", 0, "number of results, up to 500")
+	fs.IntVar(&params.Offset, "offset", 0, "offset for paging")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+	params.VenueID = fs.Arg(0)
+	params.Sort = foursquarego.TipSort(sort)
+
+	tips, _, err := c.client.Venues.Tips(params)
+	if err != nil {
+		return err
+	}
+
+	out := output{
+		value:  tips,
+		header: []string{"id", "createdAt", "user", "agreeCount", "text"},
+	}
+	for _, t := range tips {
+		out.rows = append(out.rows, []string{
+			t.ID,
+			formatTime(t.CreatedAt),
+			strings.TrimSpace(t.User.FirstName + " " + t.User.LastName),
+			strconv.Itoa(t.AgreeCount),
+			t.Text,
+		})
+	}
+	return out.write(c.stdout, *format)
+}
+
+// venueOutput writes value as json and the venues with the columns as a
+// table or csv.
+func venueOutput(value interface{}, venues []foursquarego.Venue, columns string) (output, error) {
+	cols, err := export.ColumnsByName(splitList(columns)...)
+	if err != nil {
+		return output{}, err
+	}
+
+	out := output{value: value}
+	for _, col := range cols {
+		out.header = append(out.header, col.Name)
+	}
+	for _, v := range venues {
+		row := make([]string, len(cols))
+		for i, col := range cols {
+			row[i] = col.Value(v)
+		}
+		out.rows = append(out.rows, row)
+	}
+	return out, nil
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func formatTime(t foursquarego.Timestamp) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,162 @@
+package foursquarego
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ChangeField is a part of a venue compared by Diff.
+type ChangeField string
+
+// Options for ChangeField
+const (
+	ChangeName       ChangeField = "name"
+	ChangeAddress    ChangeField = "address"
+	ChangePhone      ChangeField = "phone"
+	ChangeHours      ChangeField = "hours"
+	ChangeCategories ChangeField = "categories"
+	ChangeClosed     ChangeField = "closed"
+	ChangePhotos     ChangeField = "photos"
+)
+
+// Change is a field that differs between two copies of a venue. Old and New
+// are the field written out as text. For categories and photos the IDs
+// that were added or removed are listed too.
+type Change struct {
+	Field   ChangeField `json:"field"`
+	Old     string      `json:"old,omitempty"`
+	New     string      `json:"new,omitempty"`
+	Added   []string    `json:"added,omitempty"`
+	Removed []string    `json:"removed,omitempty"`
+}
+
+// Diff lists the changes from before to after in the order of the
+// ChangeField options. Photos are only reported when added since most
+// responses only have some of a venue's photos. A nil venue is treated as
+// an empty one.
+func Diff(before, after *Venue) []Change {
+	if before == nil {
+		before = new(Venue)
+	}
+	if after == nil {
+		after = new(Venue)
+	}
+
+	var changes []Change
+	text := func(field ChangeField, from, to string) {
+		if from != to {
+			changes = append(changes, Change{Field: field, Old: from, New: to})
+		}
+	}
+
+	text(ChangeName, before.Name, after.Name)
+	text(ChangeAddress, formatAddress(before.Location), formatAddress(after.Location))
+	text(ChangePhone, before.Contact.Phone, after.Contact.Phone)
+	text(ChangeHours, formatHours(before.Hours), formatHours(after.Hours))
+
+	oldCats, newCats := categoryIDs(before.Categories), categoryIDs(after.Categories)
+	if added, removed := diffIDs(oldCats, newCats); len(added) > 0 || len(removed) > 0 {
+		changes = append(changes, Change{
+			Field:   ChangeCategories,
+			Old:     categoryNames(before.Categories),
+			New:     categoryNames(after.Categories),
+			Added:   added,
+			Removed: removed,
+		})
+	}
+
+	text(ChangeClosed, strconv.FormatBool(before.Closed), strconv.FormatBool(after.Closed))
+
+	if added, _ := diffIDs(photoIDs(before), photoIDs(after)); len(added) > 0 {
+		changes = append(changes, Change{Field: ChangePhotos, Added: added})
+	}
+	return changes
+}
+
+// formatAddress is the formatted address on one line, or the parts of the
+// address if it has not been formatted.
+func formatAddress(l Location) string {
+	if len(l.FormattedAddress) > 0 {
+		return strings.Join(l.FormattedAddress, ", ")
+	}
+	var parts []string
+	for _, p := range []string{l.Address, l.City, l.State, l.PostalCode, l.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatHours writes the timeframes out like "Mon–Tue 5:00 PM–Midnight;
+// Sun Noon–Midnight". Status and IsOpen are ignored, they change every
+// hour.
+func formatHours(h Hours) string {
+	frames := make([]string, len(h.Timeframes))
+	for i, tf := range h.Timeframes {
+		times := make([]string, len(tf.Open))
+		for j, o := range tf.Open {
+			times[j] = o.RenderedTime
+		}
+		frames[i] = tf.Days + " " + strings.Join(times, ", ")
+	}
+	return strings.Join(frames, "; ")
+}
+
+func categoryIDs(cats []Category) []string {
+	ids := make([]string, len(cats))
+	for i, c := range cats {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func categoryNames(cats []Category) string {
+	names := make([]string, len(cats))
+	for i, c := range cats {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// photoIDs are the IDs of every photo in the venue's photo groups and its
+// best photo.
+func photoIDs(v *Venue) []string {
+	var ids []string
+	if v.BestPhoto.ID != "" {
+		ids = append(ids, v.BestPhoto.ID)
+	}
+	for _, g := range v.Photos.Groups {
+		for _, p := range g.Items {
+			ids = append(ids, p.ID)
+		}
+	}
+	return ids
+}
+
+// diffIDs returns the IDs only in after and only in before, keeping their
+// order.
+func diffIDs(before, after []string) (added, removed []string) {
+	in := func(ids []string) map[string]bool {
+		m := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			m[id] = true
+		}
+		return m
+	}
+	inBefore, inAfter := in(before), in(after)
+
+	for _, id := range after {
+		if !inBefore[id] {
+			added = append(added, id)
+			inBefore[id] = true
+		}
+	}
+	for _, id := range before {
+		if !inAfter[id] {
+			removed = append(removed, id)
+			inAfter[id] = true
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,153 @@
+package foursquarego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	before := &Venue{
+		ID:         "v1",
+		Name:       "Joe's Pizza",
+		Contact:    Contact{Phone: "2125551234"},
+		Location:   Location{Address: "7 Carmine St", City: "New York"},
+		Categories: []Category{{ID: "pizza", Name: "Pizza Place"}},
+		Hours: Hours{
+			Status:     "Open until 11:00 PM",
+			Timeframes: []TimeFrame{{Days: "Mon–Sun", Open: []Open{{RenderedTime: "11:00 AM–11:00 PM"}}}},
+		},
+		BestPhoto: Photo{ID: "p1"},
+	}
+
+	after := *before
+	after.Hours.Status = "Closed until 11:00 AM"
+	assert.Empty(t, Diff(before, &after))
+
+	after.Name = "Joe's Pizza & Pasta"
+	after.Contact.Phone = "2125559999"
+	after.Location = Location{FormattedAddress: []string{"150 E 14th St", "New York, NY 10003"}}
+	after.Categories = []Category{{ID: "italian", Name: "Italian Restaurant"}, {ID: "pizza", Name: "Pizza Place"}}
+	after.Hours = Hours{Timeframes: []TimeFrame{{Days: "Mon–Sun", Open: []Open{{RenderedTime: "Noon–10:00 PM"}}}}}
+	after.Closed = true
+	after.Photos = Photos{Groups: []PhotoGrouping{{Items: []Photo{{ID: "p1"}, {ID: "p2"}}}}}
+
+	assert.Equal(t, []Change{
+		{Field: ChangeName, Old: "Joe's Pizza", New: "Joe's Pizza & Pasta"},
+		{Field: ChangeAddress, Old: "7 Carmine St, New York", New: "150 E 14th St, New York, NY 10003"},
+		{Field: ChangePhone, Old: "2125551234", New: "2125559999"},
+		{Field: ChangeHours, Old: "Mon–Sun 11:00 AM–11:00 PM", New: "Mon–Sun Noon–10:00 PM"},
+		{Field: ChangeCategories, Old: "Pizza Place", New: "Italian Restaurant, Pizza Place", Added: []string{"italian"}},
+		{Field: ChangeClosed, Old: "false", New: "true"},
+		{Field: ChangePhotos, Added: []string{"p2"}},
+	}, Diff(before, &after))
+
+	assert.Equal(t, []Change{
+		{Field: ChangeName, New: "Joe's Pizza"},
+		{Field: ChangeAddress, New: "7 Carmine St, New York"},
+		{Field: ChangePhone, New: "2125551234"},
+		{Field: ChangeHours, New: "Mon–Sun 11:00 AM–11:00 PM"},
+		{Field: ChangeCategories, New: "Pizza Place", Added: []string{"pizza"}},
+		{Field: ChangePhotos, Added: []string{"p1"}},
+	}, Diff(nil, before))
+}
+
+// detailsStub is a VenueAPI answering Details with fn.
+type detailsStub struct {
+	VenueAPI
+	fn func(id string) (*Venue, *http.Response, error)
+}
+
+func (s detailsStub) Details(id string) (*Venue, *http.Response, error) {
+	return s.fn(id)
+}
+
+func TestWatcher(t *testing.T) {
+	names := map[string]string{"v1": "One", "v2": "Two"}
+	stub := detailsStub{fn: func(id string) (*Venue, *http.Response, error) {
+		if id == "missing" {
+			return nil, nil, errors.New("not found")
+		}
+		return &Venue{ID: id, Name: names[id]}, nil, nil
+	}}
+
+	w := NewWatcher(stub, time.Millisecond)
+	w.Add("v1")
+	w.Seed(Venue{ID: "v2", Name: "Old Two"})
+
+	ctx := context.Background()
+	events := w.Poll(ctx)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "v2", events[0].VenueID)
+		assert.Equal(t, []Change{{Field: ChangeName, Old: "Old Two", New: "Two"}}, events[0].Changes)
+	}
+	assert.Empty(t, w.Poll(ctx))
+
+	names["v1"] = "New One"
+	w.Add("missing")
+	events = w.Poll(ctx)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "v1", events[0].VenueID)
+		assert.Equal(t, "New One", events[0].Venue.Name)
+		assert.Equal(t, "missing", events[1].VenueID)
+		assert.Error(t, events[1].Err)
+	}
+
+	w.Remove("missing")
+	names["v2"] = "New Two"
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan WatchEvent)
+	done := make(chan error)
+	go func() { done <- w.Run(ctx, ch) }()
+
+	e := <-ch
+	assert.Equal(t, "v2", e.VenueID)
+	cancel()
+	for range ch {
+	}
+	assert.Equal(t, context.Canceled, <-done)
+}
+
+func TestNewWatcher_interval(t *testing.T) {
+	assert.Equal(t, defaultWatchInterval, NewWatcher(detailsStub{}, 0).interval)
+	assert.Equal(t, defaultWatchInterval, NewWatcher(detailsStub{}, -time.Second).interval)
+	assert.Equal(t, time.Minute, NewWatcher(detailsStub{}, time.Minute).interval)
+}
+
+func TestWatcher_Poll_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var fetched []string
+	stub := detailsStub{fn: func(id string) (*Venue, *http.Response, error) {
+		fetched = append(fetched, id)
+		cancel()
+		return nil, nil, errors.New("timeout")
+	}}
+
+	w := NewWatcher(stub, time.Millisecond)
+	w.Add("v1", "v2", "v3")
+	events := w.Poll(ctx)
+	assert.Equal(t, []string{"v1"}, fetched)
+	assert.Len(t, events, 1)
+}
+
+func TestWatcher_Seed_compact(t *testing.T) {
+	items := []Photo{{ID: "p1"}, {ID: "p2"}}
+	stub := detailsStub{fn: func(id string) (*Venue, *http.Response, error) {
+		photos := Photos{Count: len(items), Groups: []PhotoGrouping{{Items: items}}}
+		return &Venue{ID: id, Name: "Joe's Pizza", Photos: photos}, nil, nil
+	}}
+
+	w := NewWatcher(stub, time.Millisecond)
+	w.Seed(Venue{ID: "v1", Name: "Joe's Pizza"})
+	assert.Empty(t, w.Poll(context.Background()))
+
+	items = append(items, Photo{ID: "p3"})
+	events := w.Poll(context.Background())
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, []Change{{Field: ChangePhotos, Added: []string{"p3"}}}, events[0].Changes)
+	}
+}
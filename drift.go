@@ -0,0 +1,216 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Drift is a field in a response that none of the structs decode, a sign
+// the API has changed since the structs were written.
+type Drift struct {
+	Endpoint string `json:"endpoint"`
+	// Path is the dotted path of the field in the envelope, ex
+	// response.venue.delivery. Array elements are written as []. A
+	// notification of a type without a struct is written as
+	// notifications[].type=<type>.
+	Path string `json:"path"`
+}
+
+func (d Drift) String() string {
+	return d.Endpoint + " " + d.Path
+}
+
+// responseTypes are what receive decodes the response of each endpoint
+// into.
+var responseTypes = map[string]func() interface{}{
+	EndpointVenueDetails:      func() interface{} { return new(venueResp) },
+	EndpointCategories:        func() interface{} { return new(categoriesResp) },
+	EndpointSearch:            func() interface{} { return new(venueSearchResp) },
+	EndpointSuggestCompletion: func() interface{} { return new(venueSuggestResp) },
+	EndpointTrending:          func() interface{} { return new(venueTrendingResp) },
+	EndpointExplore:           func() interface{} { return new(VenueExploreResp) },
+	EndpointPhotos:            func() interface{} { return new(venuePhotoResp) },
+	EndpointEvents:            func() interface{} { return new(venueEventResp) },
+	EndpointHours:             func() interface{} { return new(VenueHoursResp) },
+	EndpointLikes:             func() interface{} { return new(venueLikesResp) },
+	EndpointLinks:             func() interface{} { return new(venueLinkResp) },
+	EndpointListed:            func() interface{} { return new(venueListedResp) },
+	EndpointNextVenues:        func() interface{} { return new(venueNextVenuesResp) },
+	EndpointMenu:              func() interface{} { return new(venueMenuResp) },
+	EndpointTips:              func() interface{} { return new(tipResp) },
+}
+
+// CheckDrift decodes a response envelope from the endpoint into the typed
+// structs and reports every field that was not decoded. An error is
+// returned if the body does not decode, such as when a field changed type.
+func CheckDrift(endpoint string, body []byte) ([]Drift, error) {
+	newResponse, ok := responseTypes[endpoint]
+	if !ok {
+		return nil, fmt.Errorf("foursquare: unknown endpoint %q", endpoint)
+	}
+
+	envelope := new(Response)
+	if err := json.Unmarshal(body, envelope); err != nil {
+		return nil, fmt.Errorf("foursquare: %s: %v", endpoint, err)
+	}
+	response := newResponse()
+	if len(envelope.Response) > 0 {
+		if err := json.Unmarshal(envelope.Response, response); err != nil {
+			return nil, fmt.Errorf("foursquare: %s: %v", endpoint, err)
+		}
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(body, &generic); err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	for key, value := range generic {
+		switch key {
+		case "meta":
+			findDrift(key, value, reflect.TypeOf(envelope.Meta), found)
+		case "response":
+			findDrift(key, value, reflect.TypeOf(response), found)
+		case "notifications":
+			findNotificationDrift(value, envelope.Notifications, found)
+		default:
+			found[key] = true
+		}
+	}
+
+	paths := make([]string, 0, len(found))
+	for path := range found {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	drift := make([]Drift, len(paths))
+	for i, path := range paths {
+		drift[i] = Drift{Endpoint: endpoint, Path: path}
+	}
+	return drift, nil
+}
+
+// findNotificationDrift checks each notification's item against the type
+// it was decoded into based on its type.
+func findNotificationDrift(value interface{}, notifications []Notification, found map[string]bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+	for i, v := range items {
+		fields, ok := v.(map[string]interface{})
+		if !ok || i >= len(notifications) {
+			continue
+		}
+		for key, item := range fields {
+			switch key {
+			case "type":
+			case "item":
+				if raw, ok := notifications[i].Item.(*RawNotification); ok {
+					found["notifications[].type="+raw.Type] = true
+					continue
+				}
+				findDrift("notifications[].item", item, reflect.TypeOf(notifications[i].Item), found)
+			default:
+				found["notifications[]."+key] = true
+			}
+		}
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// findDrift walks the decoded json alongside the type it was decoded into
+// and adds the path of every object key without a field to found.
+func findDrift(path string, value interface{}, t reflect.Type, found map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types decoding themselves, like Timestamp, are trusted.
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, v := range value {
+				field, ok := lookupField(fields, key)
+				if !ok {
+					found[path+"."+key] = true
+					continue
+				}
+				findDrift(path+"."+key, v, field, found)
+			}
+		case reflect.Map:
+			for key, v := range value {
+				findDrift(path+"."+key, v, t.Elem(), found)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for _, v := range value {
+			findDrift(path+"[]", v, t.Elem(), found)
+		}
+	}
+}
+
+// jsonFields maps the json names of a struct's fields, including the
+// fields of embedded structs, to their types.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for k, v := range jsonFields(embedded) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookupField matches a key to a field the way encoding/json does, exact
+// first then case insensitive.
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,103 @@
+package foursquarego
+
+import (
+	"flag"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateDrift = flag.Bool("update-drift", false, "rewrite json/drift.golden")
+
+// fixtureEndpoints are the endpoints the files in json/venues are from.
+var fixtureEndpoints = map[string]string{
+	"categories":                  EndpointCategories,
+	"details":                     EndpointVenueDetails,
+	"details_populated_synthetic": EndpointVenueDetails,
+	"events":                      EndpointEvents,
+	"explore":                     EndpointExplore,
+	"hours":                       EndpointHours,
+	"likes":                       EndpointLikes,
+	"links":                       EndpointLinks,
+	"listed":                      EndpointListed,
+	"menu":                        EndpointMenu,
+	"menu_options_synthetic":      EndpointMenu,
+	"nextvenues":                  EndpointNextVenues,
+	"photos":                      EndpointPhotos,
+	"search":                      EndpointSearch,
+	"suggest":                     EndpointSuggestCompletion,
+	"tips":                        EndpointTips,
+	"trending":                    EndpointTrending,
+}
+
+// TestCheckDrift_fixtures decodes every fixture strictly. Fields the
+// structs are missing are listed in json/drift.golden, update it with
+// go test -run TestCheckDrift_fixtures -update-drift.
+func TestCheckDrift_fixtures(t *testing.T) {
+	files, err := ioutil.ReadDir("./json/venues")
+	assert.Nil(t, err)
+
+	var report strings.Builder
+	for _, f := range files {
+		name := strings.TrimSuffix(f.Name(), ".json")
+		endpoint, ok := fixtureEndpoints[name]
+		if !assert.True(t, ok, "no endpoint for fixture %s", f.Name()) {
+			continue
+		}
+
+		b, err := getTestFile("./json/venues/" + f.Name())
+		assert.Nil(t, err)
+
+		drift, err := CheckDrift(endpoint, b)
+		assert.Nil(t, err, f.Name())
+		for _, d := range drift {
+			report.WriteString(name + ": " + d.Path + "\n")
+		}
+	}
+
+	if *updateDrift {
+		assert.Nil(t, ioutil.WriteFile("./json/drift.golden", []byte(report.String()), 0644))
+		return
+	}
+	golden, err := getTestFile("./json/drift.golden")
+	assert.Nil(t, err)
+	assert.Equal(t, string(golden), report.String())
+}
+
+func TestCheckDrift(t *testing.T) {
+	body := []byte(`{
+		"meta": {"code": 200, "requestId": "x", "newMeta": 1},
+		"response": {"venue": {
+			"id": "v1",
+			"NAME": "case insensitive",
+			"delivery": {"provider": {"name": "seamless"}},
+			"location": {"lat": 1, "formattedAddressV2": "1 Main St"},
+			"categories": [{"id": "c1", "color": "red"}, {"id": "c2", "color": "blue"}],
+			"createdAt": 1505000000
+		}},
+		"notifications": [
+			{"type": "notificationTray", "item": {"unreadCount": 1, "unreadMentions": 0}},
+			{"type": "checkinPrompt", "item": {"venue": "v1"}, "priority": 2}
+		]
+	}`)
+
+	drift, err := CheckDrift(EndpointVenueDetails, body)
+	assert.Nil(t, err)
+	assert.Equal(t, []Drift{
+		{EndpointVenueDetails, "meta.newMeta"},
+		{EndpointVenueDetails, "notifications[].item.unreadMentions"},
+		{EndpointVenueDetails, "notifications[].priority"},
+		{EndpointVenueDetails, "notifications[].type=checkinPrompt"},
+		{EndpointVenueDetails, "response.venue.categories[].color"},
+		{EndpointVenueDetails, "response.venue.delivery"},
+		{EndpointVenueDetails, "response.venue.location.formattedAddressV2"},
+	}, drift)
+
+	_, err = CheckDrift(EndpointVenueDetails, []byte(`{"response": {"venue": {"name": 5}}}`))
+	assert.Error(t, err)
+
+	_, err = CheckDrift("venues.unknown", body)
+	assert.Error(t, err)
+}
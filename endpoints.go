@@ -0,0 +1,83 @@
+package foursquarego
+
+import "strings"
+
+// Logical endpoint names, see Endpoint.
+const (
+	EndpointVenueDetails      = "venues.details"
+	EndpointCategories        = "venues.categories"
+	EndpointSearch            = "venues.search"
+	EndpointSuggestCompletion = "venues.suggestCompletion"
+	EndpointTrending          = "venues.trending"
+	EndpointExplore           = "venues.explore"
+	EndpointPhotos            = "venues.photos"
+	EndpointEvents            = "venues.events"
+	EndpointHours             = "venues.hours"
+	EndpointLikes             = "venues.likes"
+	EndpointLinks             = "venues.links"
+	EndpointListed            = "venues.listed"
+	EndpointNextVenues        = "venues.nextVenues"
+	EndpointMenu              = "venues.menu"
+	EndpointTips              = "venues.tips"
+)
+
+// venueIDSegment stands for the venue id in the paths of Endpoints.
+const venueIDSegment = "VENUE_ID"
+
+// Endpoints are the paths of the endpoints the client calls, relative to
+// /v2/ with VENUE_ID in place of the venue id, keyed by logical name.
+var Endpoints = map[string]string{
+	EndpointVenueDetails:      "venues/VENUE_ID",
+	EndpointCategories:        "venues/categories",
+	EndpointSearch:            "venues/search",
+	EndpointSuggestCompletion: "venues/suggestCompletion",
+	EndpointTrending:          "venues/trending",
+	EndpointExplore:           "venues/explore",
+	EndpointPhotos:            "venues/VENUE_ID/photos",
+	EndpointEvents:            "venues/VENUE_ID/events",
+	EndpointHours:             "venues/VENUE_ID/hours",
+	EndpointLikes:             "venues/VENUE_ID/likes",
+	EndpointLinks:             "venues/VENUE_ID/links",
+	EndpointListed:            "venues/VENUE_ID/listed",
+	EndpointNextVenues:        "venues/VENUE_ID/nextvenues",
+	EndpointMenu:              "venues/VENUE_ID/menu",
+	EndpointTips:              "venues/VENUE_ID/tips",
+}
+
+// EndpointForPath returns the logical endpoint name of a request path such
+// as /v2/venues/5414d0a6498ea3d31a3c64cf/hours and the venue id in it. The
+// endpoint is empty if the path is not in Endpoints. Fixed paths such as
+// venues/search win over a venue id.
+func EndpointForPath(path string) (endpoint, venueID string) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(path, "/v2/"), "/"), "/")
+
+	for name, pattern := range Endpoints {
+		id, ok := matchPath(parts, strings.Split(pattern, "/"))
+		if !ok {
+			continue
+		}
+		if id == "" {
+			return name, ""
+		}
+		endpoint, venueID = name, id
+	}
+	return endpoint, venueID
+}
+
+// matchPath matches the parts of a path to a pattern from Endpoints and
+// returns the segment in place of VENUE_ID.
+func matchPath(parts, pattern []string) (string, bool) {
+	if len(parts) != len(pattern) {
+		return "", false
+	}
+	id := ""
+	for i, p := range pattern {
+		switch {
+		case p == venueIDSegment && parts[i] != "":
+			id = parts[i]
+		case p != parts[i]:
+			return "", false
+		}
+	}
+	return id, true
+}
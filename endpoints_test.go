@@ -0,0 +1,36 @@
+package foursquarego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointForPath(t *testing.T) {
+	cases := map[string][2]string{
+		"/v2/venues/5414d0a6498ea3d31a3c64cf":            {"venues.details", "5414d0a6498ea3d31a3c64cf"},
+		"/v2/venues/5414d0a6498ea3d31a3c64cf/nextvenues": {"venues.nextVenues", "5414d0a6498ea3d31a3c64cf"},
+		"/v2/venues/search":                              {"venues.search", ""},
+		"/v2/venues/categories/":                         {"venues.categories", ""},
+		"/v2/venues/suggestCompletion":                   {"venues.suggestCompletion", ""},
+		"/v2/venues/1/unknown":                           {"", ""},
+		"/v2/users/self":                                 {"", ""},
+	}
+	for path, want := range cases {
+		endpoint, id := EndpointForPath(path)
+		assert.Equal(t, want, [2]string{endpoint, id}, path)
+	}
+}
+
+// TestEndpoints_receive checks every endpoint the VenueService calls is
+// named and found from its path.
+func TestEndpoints_receive(t *testing.T) {
+	for name := range responseTypes {
+		pattern, ok := Endpoints[name]
+		if assert.True(t, ok, name) {
+			endpoint, _ := EndpointForPath("/v2/" + pattern)
+			assert.Equal(t, name, endpoint)
+		}
+	}
+	assert.Len(t, Endpoints, len(responseTypes))
+}
@@ -25,3 +25,14 @@ func relevantError(httpError error, resp Response) error {
 
 	return nil
 }
+
+// ParamError is returned without making a request when the parameters
+// break a documented rule that foursquare would reject with a param_error.
+type ParamError struct {
+	Param  string
+	Detail string
+}
+
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("foursquare: invalid %s: %s", e.Param, e.Detail)
+}
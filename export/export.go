@@ -0,0 +1,233 @@
+// Package export writes foursquarego venues as CSV or JSON Lines for
+// spreadsheets and large sweeps, and as KML or GPX for maps and GPS devices.
+//
+//	w := export.NewCSVWriter(os.Stdout, export.DefaultColumns...)
+//	for _, v := range venues {
+//		w.Write(v)
+//	}
+//	w.Flush()
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/peppage/foursquarego"
+)
+
+// Column is a CSV column, Value flattens the venue into the cell.
+type Column struct {
+	Name  string
+	Value func(v foursquarego.Venue) string
+}
+
+// Columns available for CSV
+var (
+	ColumnID          = Column{"id", func(v foursquarego.Venue) string { return v.ID }}
+	ColumnName        = Column{"name", func(v foursquarego.Venue) string { return v.Name }}
+	ColumnLat         = Column{"lat", func(v foursquarego.Venue) string { return formatCoordinate(v.Location.Lat) }}
+	ColumnLng         = Column{"lng", func(v foursquarego.Venue) string { return formatCoordinate(v.Location.Lng) }}
+	ColumnAddress     = Column{"address", func(v foursquarego.Venue) string { return v.Location.Address }}
+	ColumnCrossStreet = Column{"crossStreet", func(v foursquarego.Venue) string { return v.Location.CrossStreet }}
+	ColumnCity        = Column{"city", func(v foursquarego.Venue) string { return v.Location.City }}
+	ColumnState       = Column{"state", func(v foursquarego.Venue) string { return v.Location.State }}
+	ColumnPostalCode  = Column{"postalCode", func(v foursquarego.Venue) string { return v.Location.PostalCode }}
+	ColumnCountry     = Column{"country", func(v foursquarego.Venue) string { return v.Location.Country }}
+	ColumnCategory    = Column{"category", func(v foursquarego.Venue) string { return primaryCategory(v).Name }}
+	ColumnCategoryID  = Column{"categoryId", func(v foursquarego.Venue) string { return primaryCategory(v).ID }}
+	ColumnRating      = Column{"rating", func(v foursquarego.Venue) string { return formatFloat(v.Rating) }}
+	ColumnPriceTier   = Column{"priceTier", func(v foursquarego.Venue) string { return formatInt(v.Price.Tier) }}
+	ColumnCheckins    = Column{"checkinsCount", func(v foursquarego.Venue) string { return formatInt(v.Stats.CheckinsCount) }}
+	ColumnUsers       = Column{"usersCount", func(v foursquarego.Venue) string { return formatInt(v.Stats.UsersCount) }}
+	ColumnTips        = Column{"tipCount", func(v foursquarego.Venue) string { return formatInt(v.Stats.TipCount) }}
+	ColumnVisits      = Column{"visitsCount", func(v foursquarego.Venue) string { return formatInt(v.Stats.VisitsCount) }}
+	ColumnURL         = Column{"url", func(v foursquarego.Venue) string { return v.URL }}
+)
+
+// AllColumns are all the columns available.
+var AllColumns = []Column{
+	ColumnID, ColumnName, ColumnLat, ColumnLng,
+	ColumnAddress, ColumnCrossStreet, ColumnCity, ColumnState, ColumnPostalCode, ColumnCountry,
+	ColumnCategory, ColumnCategoryID, ColumnRating, ColumnPriceTier,
+	ColumnCheckins, ColumnUsers, ColumnTips, ColumnVisits, ColumnURL,
+}
+
+// DefaultColumns are used when no columns are given.
+var DefaultColumns = []Column{
+	ColumnID, ColumnName, ColumnLat, ColumnLng,
+	ColumnAddress, ColumnCity, ColumnState, ColumnPostalCode, ColumnCountry,
+	ColumnCategory, ColumnRating, ColumnPriceTier,
+	ColumnCheckins, ColumnUsers, ColumnTips, ColumnURL,
+}
+
+// ColumnsByName looks up columns by their Name, such as the names given
+// on a command line.
+func ColumnsByName(names ...string) ([]Column, error) {
+	var columns []Column
+	for _, name := range names {
+		found := false
+		for _, c := range AllColumns {
+			if c.Name == name {
+				columns = append(columns, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("export: unknown column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// Writer writes venues one at a time.
+type Writer interface {
+	Write(v foursquarego.Venue) error
+	Flush() error
+}
+
+// CSVWriter writes venues as CSV rows with a header row first.
+type CSVWriter struct {
+	w           *csv.Writer
+	columns     []Column
+	wroteHeader bool
+}
+
+// NewCSVWriter returns a CSVWriter with the columns, or DefaultColumns if
+// none are given.
+func NewCSVWriter(w io.Writer, columns ...Column) *CSVWriter {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	return &CSVWriter{
+		w:       csv.NewWriter(w),
+		columns: columns,
+	}
+}
+
+// Write writes the venue as a row.
+func (c *CSVWriter) Write(v foursquarego.Venue) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(c.columns))
+	for i, col := range c.columns {
+		row[i] = col.Value(v)
+	}
+	return c.w.Write(row)
+}
+
+// Flush writes any buffered rows, and the header if there were no venues.
+func (c *CSVWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *CSVWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	header := make([]string, len(c.columns))
+	for i, col := range c.columns {
+		header[i] = col.Name
+	}
+	if err := c.w.Write(header); err != nil {
+		return err
+	}
+	c.wroteHeader = true
+	return nil
+}
+
+// JSONLWriter writes every venue as a json object on its own line.
+type JSONLWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLWriter returns a JSONLWriter.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	b := bufio.NewWriter(w)
+	return &JSONLWriter{
+		w:   b,
+		enc: json.NewEncoder(b),
+	}
+}
+
+// Write writes the venue as a line.
+func (j *JSONLWriter) Write(v foursquarego.Venue) error {
+	return j.enc.Encode(v)
+}
+
+// Flush writes any buffered lines.
+func (j *JSONLWriter) Flush() error {
+	return j.w.Flush()
+}
+
+// WriteAll writes the venues and flushes.
+func WriteAll(w Writer, venues []foursquarego.Venue) error {
+	for _, v := range venues {
+		if err := w.Write(v); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// Drain writes venues from the channel until it is closed and flushes,
+// returning the number written. After an error the channel is still
+// drained so the sender is not blocked.
+func Drain(w Writer, venues <-chan foursquarego.Venue) (int, error) {
+	var err error
+	n := 0
+	for v := range venues {
+		if err != nil {
+			continue
+		}
+		if err = w.Write(v); err == nil {
+			n++
+		}
+	}
+	if err != nil {
+		return n, err
+	}
+	return n, w.Flush()
+}
+
+func primaryCategory(v foursquarego.Venue) foursquarego.Category {
+	for _, c := range v.Categories {
+		if c.Primary {
+			return c
+		}
+	}
+	if len(v.Categories) > 0 {
+		return v.Categories[0]
+	}
+	return foursquarego.Category{}
+}
+
+func formatFloat(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// formatCoordinate is formatFloat where 0 is a valid value.
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+	return strconv.Itoa(i)
+}
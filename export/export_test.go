@@ -0,0 +1,81 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/stretchr/testify/assert"
+)
+
+var testVenues = []foursquarego.Venue{
+	{
+		ID:   "5414d0a6498ea3d31a3c64cf",
+		Name: "Threes Brewing",
+		Location: foursquarego.Location{
+			Lat:     40.67979901271337,
+			Lng:     -73.98215935484912,
+			Address: "333 Douglass St",
+			City:    "Brooklyn",
+		},
+		Categories: []foursquarego.Category{
+			{ID: "4bf58dd8d48988d116941735", Name: "Bar"},
+			{ID: "50327c8591d4c4b30a586d5d", Name: "Brewery", Primary: true},
+		},
+		Rating: 9.4,
+		Price:  foursquarego.Price{Tier: 2},
+		Stats:  foursquarego.Stats{CheckinsCount: 15477},
+	},
+	{
+		ID:   "4f68de6bd5fbee32e5f4f3a5",
+		Name: "SingleCut Beersmiths, \"Queens\"",
+	},
+}
+
+func TestCSVWriter(t *testing.T) {
+	columns, err := ColumnsByName("id", "name", "lat", "lng", "address", "category", "rating", "priceTier", "checkinsCount")
+	assert.Nil(t, err)
+
+	var b bytes.Buffer
+	assert.Nil(t, WriteAll(NewCSVWriter(&b, columns...), testVenues))
+
+	assert.Equal(t, strings.Join([]string{
+		"id,name,lat,lng,address,category,rating,priceTier,checkinsCount",
+		"5414d0a6498ea3d31a3c64cf,Threes Brewing,40.67979901271337,-73.98215935484912,333 Douglass St,Brewery,9.4,2,15477",
+		`4f68de6bd5fbee32e5f4f3a5,"SingleCut Beersmiths, ""Queens""",0,0,,,,,`,
+		"",
+	}, "\n"), b.String())
+
+	_, err = ColumnsByName("id", "nope")
+	assert.Error(t, err)
+}
+
+func TestCSVWriter_noVenues(t *testing.T) {
+	var b bytes.Buffer
+	assert.Nil(t, WriteAll(NewCSVWriter(&b, ColumnID, ColumnLat), nil))
+	assert.Equal(t, "id,lat\n", b.String())
+}
+
+func TestJSONLWriter(t *testing.T) {
+	venues := make(chan foursquarego.Venue)
+	go func() {
+		for _, v := range testVenues {
+			venues <- v
+		}
+		close(venues)
+	}()
+
+	var b bytes.Buffer
+	n, err := Drain(NewJSONLWriter(&b), venues)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	var v foursquarego.Venue
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &v))
+	assert.Equal(t, "4f68de6bd5fbee32e5f4f3a5", v.ID)
+}
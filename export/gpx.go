@@ -0,0 +1,59 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/peppage/foursquarego"
+)
+
+const gpxNamespace = "http://www.topografix.com/GPX/1/1"
+
+type gpx struct {
+	XMLName   xml.Name      `xml:"gpx"`
+	Xmlns     string        `xml:"xmlns,attr"`
+	Version   string        `xml:"version,attr"`
+	Creator   string        `xml:"creator,attr"`
+	Name      string        `xml:"metadata>name,omitempty"`
+	Waypoints []gpxWaypoint `xml:"wpt"`
+}
+
+type gpxWaypoint struct {
+	Lat         string   `xml:"lat,attr"`
+	Lon         string   `xml:"lon,attr"`
+	Name        string   `xml:"name"`
+	Description string   `xml:"desc,omitempty"`
+	Link        *gpxLink `xml:"link,omitempty"`
+	Type        string   `xml:"type,omitempty"`
+}
+
+type gpxLink struct {
+	Href string `xml:"href,attr"`
+}
+
+// WriteGPX writes the venues as GPX 1.1 waypoints for GPS devices. The
+// waypoint type is the primary category.
+func WriteGPX(w io.Writer, name string, venues []foursquarego.Venue) error {
+	doc := gpx{
+		Xmlns:   gpxNamespace,
+		Version: "1.1",
+		Creator: "foursquarego",
+		Name:    name,
+	}
+
+	for _, v := range venues {
+		wpt := gpxWaypoint{
+			Lat:         formatCoordinate(v.Location.Lat),
+			Lon:         formatCoordinate(v.Location.Lng),
+			Name:        v.Name,
+			Description: Description(v),
+			Type:        primaryCategory(v).Name,
+		}
+		if v.CanonicalURL != "" {
+			wpt.Link = &gpxLink{Href: v.CanonicalURL}
+		}
+		doc.Waypoints = append(doc.Waypoints, wpt)
+	}
+
+	return writeXML(w, doc)
+}
@@ -0,0 +1,126 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/peppage/foursquarego"
+)
+
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+type kml struct {
+	XMLName  xml.Name    `xml:"kml"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	Document kmlDocument `xml:"Document"`
+}
+
+type kmlDocument struct {
+	Name       string         `xml:"name,omitempty"`
+	Styles     []kmlStyle     `xml:"Style"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlStyle struct {
+	ID   string `xml:"id,attr"`
+	Href string `xml:"IconStyle>Icon>href"`
+}
+
+type kmlPlacemark struct {
+	ID          string `xml:"id,attr,omitempty"`
+	Name        string `xml:"name"`
+	Description string `xml:"description,omitempty"`
+	StyleURL    string `xml:"styleUrl,omitempty"`
+	Coordinates string `xml:"Point>coordinates"`
+}
+
+// WriteKML writes the venues as KML placemarks for Google Earth. Every
+// primary category gets a style using its icon.
+func WriteKML(w io.Writer, name string, venues []foursquarego.Venue) error {
+	doc := kml{
+		Xmlns:    kmlNamespace,
+		Document: kmlDocument{Name: name},
+	}
+
+	styled := map[string]bool{}
+	for _, v := range venues {
+		p := kmlPlacemark{
+			ID:          v.ID,
+			Name:        v.Name,
+			Description: Description(v),
+			Coordinates: formatCoordinate(v.Location.Lng) + "," + formatCoordinate(v.Location.Lat),
+		}
+
+		if c := primaryCategory(v); c.ID != "" {
+			style := "category-" + c.ID
+			p.StyleURL = "#" + style
+			if !styled[style] {
+				styled[style] = true
+				doc.Document.Styles = append(doc.Document.Styles, kmlStyle{
+					ID:   style,
+					Href: c.Icon.URL(foursquarego.IconSize64),
+				})
+			}
+		}
+
+		doc.Document.Placemarks = append(doc.Document.Placemarks, p)
+	}
+
+	return writeXML(w, doc)
+}
+
+// ListVenues returns the venues of the items on a list. Items without a
+// venue, such as tips, are skipped.
+func ListVenues(l foursquarego.List) []foursquarego.Venue {
+	var venues []foursquarego.Venue
+	for _, item := range l.ListItems.Items {
+		if item.Venue != nil {
+			venues = append(venues, *item.Venue)
+		}
+	}
+	return venues
+}
+
+// Description is the venue's formatted address followed by its contact
+// details, one per line.
+func Description(v foursquarego.Venue) string {
+	lines := append([]string(nil), v.Location.FormattedAddress...)
+
+	c := v.Contact
+	switch {
+	case c.FormattedPhone != "":
+		lines = append(lines, "Phone: "+c.FormattedPhone)
+	case c.Phone != "":
+		lines = append(lines, "Phone: "+c.Phone)
+	}
+	if c.Twitter != "" {
+		lines = append(lines, "Twitter: @"+c.Twitter)
+	}
+	if c.Instagram != "" {
+		lines = append(lines, "Instagram: @"+c.Instagram)
+	}
+	switch {
+	case c.FacebookUsername != "":
+		lines = append(lines, "Facebook: "+c.FacebookUsername)
+	case c.Facebook != "":
+		lines = append(lines, "Facebook: "+c.Facebook)
+	}
+	if v.URL != "" {
+		lines = append(lines, v.URL)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,80 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/stretchr/testify/assert"
+)
+
+var threes = foursquarego.Venue{
+	ID:   "5414d0a6498ea3d31a3c64cf",
+	Name: "Threes Brewing",
+	Contact: foursquarego.Contact{
+		Phone:          "7185222110",
+		FormattedPhone: "(718) 522-2110",
+		Twitter:        "threesbrewing",
+	},
+	Location: foursquarego.Location{
+		Lat:              40.67979901271337,
+		Lng:              -73.98215935484912,
+		FormattedAddress: []string{"333 Douglass St (at 4th Ave)", "Brooklyn, NY 11217"},
+	},
+	CanonicalURL: "https://foursquare.com/v/threes-brewing/5414d0a6498ea3d31a3c64cf",
+	Categories: []foursquarego.Category{{
+		ID:      "50327c8591d4c4b30a586d5d",
+		Name:    "Brewery",
+		Primary: true,
+		Icon: foursquarego.Icon{
+			Prefix: "https://ss3.4sqi.net/img/categories_v2/food/brewery_",
+			Suffix: ".png",
+		},
+	}},
+}
+
+func TestDescription(t *testing.T) {
+	assert.Equal(t, "333 Douglass St (at 4th Ave)\nBrooklyn, NY 11217\nPhone: (718) 522-2110\nTwitter: @threesbrewing", Description(threes))
+}
+
+func TestWriteKML(t *testing.T) {
+	list := foursquarego.List{
+		Name: "Breweries",
+		ListItems: foursquarego.ListItems{Items: []foursquarego.ListItem{
+			{ID: "v5414d0a6498ea3d31a3c64cf", Venue: &threes},
+			{ID: "t5692caa3498efc71821e8c54"},
+		}},
+	}
+
+	var b bytes.Buffer
+	assert.Nil(t, WriteKML(&b, list.Name, ListVenues(list)))
+
+	var doc kml
+	assert.Nil(t, xml.Unmarshal(b.Bytes(), &doc))
+	assert.Equal(t, kmlNamespace, doc.Xmlns)
+	assert.Equal(t, "Breweries", doc.Document.Name)
+	assert.Len(t, doc.Document.Styles, 1)
+	assert.Equal(t, "category-50327c8591d4c4b30a586d5d", doc.Document.Styles[0].ID)
+	assert.Equal(t, "https://ss3.4sqi.net/img/categories_v2/food/brewery_64.png", doc.Document.Styles[0].Href)
+	assert.Len(t, doc.Document.Placemarks, 1)
+	assert.Equal(t, "Threes Brewing", doc.Document.Placemarks[0].Name)
+	assert.Equal(t, "#category-50327c8591d4c4b30a586d5d", doc.Document.Placemarks[0].StyleURL)
+	assert.Equal(t, "-73.98215935484912,40.67979901271337", doc.Document.Placemarks[0].Coordinates)
+	assert.Equal(t, Description(threes), doc.Document.Placemarks[0].Description)
+}
+
+func TestWriteGPX(t *testing.T) {
+	var b bytes.Buffer
+	assert.Nil(t, WriteGPX(&b, "Breweries", []foursquarego.Venue{threes}))
+
+	var doc gpx
+	assert.Nil(t, xml.Unmarshal(b.Bytes(), &doc))
+	assert.Equal(t, "1.1", doc.Version)
+	assert.Equal(t, "Breweries", doc.Name)
+	assert.Len(t, doc.Waypoints, 1)
+	assert.Equal(t, "40.67979901271337", doc.Waypoints[0].Lat)
+	assert.Equal(t, "-73.98215935484912", doc.Waypoints[0].Lon)
+	assert.Equal(t, "Brewery", doc.Waypoints[0].Type)
+	assert.Equal(t, threes.CanonicalURL, doc.Waypoints[0].Link.Href)
+}
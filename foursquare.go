@@ -2,6 +2,7 @@ package foursquarego
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -16,17 +17,24 @@ const (
 	headerRatePath      = "X-RateLimit-Path"
 )
 
-// Client is a Foursquare client for making Foursquare API requests.
+// Client is a Foursquare client for making Foursquare API requests. Make
+// one with NewClient or NewClientWithVenues, a Client literal has no
+// http client and panics.
 type Client struct {
 	sling *sling.Sling
+	doer  *doer
 
 	// Services used for talking to different parts of the API
-	Venues *VenueService
+	Venues VenueAPI
 }
 
 // NewClient returns a new Client.
 func NewClient(httpClient *http.Client, mode, clientID, clientSecret, accessToken string) *Client {
-	b := sling.New().Client(httpClient).Base(baseURL)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	d := &doer{base: httpClient, client: httpClient}
+	b := sling.New().Doer(d).Base(baseURL)
 	b.QueryStruct(struct {
 		V            string `url:"v"`
 		M            string `url:"m"`
@@ -43,16 +51,62 @@ func NewClient(httpClient *http.Client, mode, clientID, clientSecret, accessToke
 
 	return &Client{
 		sling:  b,
+		doer:   d,
 		Venues: newVenueService(b.New()),
 	}
 }
 
+// NewClientWithVenues returns a Client whose Venues is venues, such as a
+// foursquaremock.VenueAPI. The rest of the Client uses http.DefaultClient
+// without credentials.
+func NewClientWithVenues(venues VenueAPI) *Client {
+	c := NewClient(nil, "foursquare", "", "", "")
+	c.Venues = venues
+	return c
+}
+
 // RawRequest allows you to make any request you want. This will automatically add
 // the client/user tokens. Gives back exactly the response from foursquare.
 func (c *Client) RawRequest(url string) (*Response, *http.Response, error) {
 	response := new(Response)
-	resp, err := c.sling.New().Get(url).Receive(response, response)
-	return response, resp, relevantError(err, *response)
+	resp, err := send(c.sling.New().Get(url), "raw", response, nil)
+	return response, resp, err
+}
+
+// send makes the request labeled with the logical endpoint name and decodes
+// the envelope into response. The envelope is copied to result if it is set.
+func send(s *sling.Sling, endpoint string, response *Response, result *Result) (*http.Response, error) {
+	req, err := s.Request()
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(withEndpoint(req.Context(), endpoint))
+
+	resp, err := s.Do(req, response, response)
+	if result != nil {
+		*result = Result{
+			Meta:          response.Meta,
+			Notifications: response.Notifications,
+			Response:      resp,
+		}
+		if resp != nil {
+			result.RateLimit = ParseRate(resp)
+		}
+	}
+	return resp, relevantError(err, *response)
+}
+
+// receive is send for the typed endpoints, the object we want is in
+// the response field of the envelope.
+func receive(s *sling.Sling, endpoint string, v interface{}, result *Result) (*http.Response, error) {
+	response := new(Response)
+	resp, err := send(s, endpoint, response, result)
+	if err == nil && len(response.Response) > 0 {
+		if err := json.Unmarshal(response.Response, v); err != nil {
+			return resp, fmt.Errorf("foursquare: decoding %s: %v", endpoint, err)
+		}
+	}
+	return resp, err
 }
 
 // Response is a typical foursquare response
@@ -63,6 +117,16 @@ type Response struct {
 	Response      json.RawMessage `json:"response"`
 }
 
+// Result is the envelope around a typed response. It has the Meta with the
+// RequestID needed for Foursquare support, the notifications, the rate limit
+// and the raw http.Response.
+type Result struct {
+	Meta          Meta
+	Notifications []Notification
+	RateLimit     *RateLimit
+	Response      *http.Response
+}
+
 // Meta contains request information and error details
 // https://developer.foursquare.com/docs/api/troubleshooting/errors
 type Meta struct {
@@ -72,13 +136,6 @@ type Meta struct {
 	RequestID   string `json:"requestId"`
 }
 
-// Notification comes with all responses.
-// https://developer.foursquare.com/docs/responses/notifications
-type Notification struct {
-	Type string  `json:"type"`
-	Item Omitted `json:"item"`
-}
-
 // Group contains the default fields in a group. A lot of responses
 // share these fields.
 type Group struct {
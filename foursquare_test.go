@@ -1,13 +1,17 @@
 package foursquarego
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/peppage/foursquarego/cassette"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,8 +42,32 @@ func (t *RewriteTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return t.Transport.RoundTrip(req)
 }
 
-func assertMethod(t *testing.T, expectedMethod string, req *http.Request) {
-	assert.Equal(t, expectedMethod, req.Method)
+// cassetteClient returns a Client replaying json/cassettes/name.json. With
+// FOURSQUARE_RECORD set it records the cassette from the real API using
+// FOURSQUARE_CLIENT_ID and FOURSQUARE_CLIENT_SECRET instead.
+func cassetteClient(t *testing.T, name string) (*Client, func()) {
+	path := "./json/cassettes/" + name + ".json"
+	id, secret := clientID, clientSecret
+
+	mode := cassette.Replay
+	if os.Getenv("FOURSQUARE_RECORD") != "" {
+		mode = cassette.Record
+		id, secret = os.Getenv("FOURSQUARE_CLIENT_ID"), os.Getenv("FOURSQUARE_CLIENT_SECRET")
+	}
+
+	rec, err := cassette.New(path, mode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Synthetic() {
+		t.Logf("%s is synthetic, record it with FOURSQUARE_RECORD=1 to check the real API", path)
+	}
+	stop := func() {
+		if err := rec.Stop(); err != nil {
+			t.Error(err)
+		}
+	}
+	return NewClient(rec.Client(), "foursquare", id, secret, ""), stop
 }
 
 func assertQueryNoUser(t *testing.T, expected map[string]string, req *http.Request) {
@@ -68,6 +96,30 @@ func getTestFile(path string) ([]byte, error) {
 	return ioutil.ReadAll(f)
 }
 
+func TestVenueService_cassette(t *testing.T) {
+	client, stop := cassetteClient(t, "venues")
+	defer stop()
+
+	venue, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+
+	venues, _, err := client.Venues.Search(&VenueSearchParams{
+		Point: &LatLong{Lat: 40.7, Lng: -74},
+		Query: "singlecut",
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, venues, 1) {
+		assert.Equal(t, "SingleCut Beersmiths", venues[0].Name)
+	}
+
+	_, resp, err := client.Venues.Details("missing")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	if apiErr, ok := err.(*APIError); assert.True(t, ok) {
+		assert.Equal(t, "param_error", apiErr.Meta.ErrorType)
+	}
+}
+
 func TestRateLimit(t *testing.T) {
 	resp := http.Response{
 		Header: make(http.Header),
@@ -83,3 +135,200 @@ func TestRateLimit(t *testing.T) {
 	assert.Equal(t, "/v2/venues/X", rl.Path)
 	assert.Equal(t, 4999, rl.Remaining)
 }
+
+type testLogger struct {
+	info  [][]interface{}
+	debug [][]interface{}
+}
+
+func (l *testLogger) Info(msg string, args ...interface{}) {
+	l.info = append(l.info, args)
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) {
+	l.debug = append(l.debug, args)
+}
+
+func logValue(args []interface{}, key string) interface{} {
+	for i := 0; i+1 < len(args); i += 2 {
+		if args[i] == key {
+			return args[i+1]
+		}
+	}
+	return nil
+}
+
+func TestClient_SetLogger(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/categories", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4998")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"meta":{"code":200,"requestId":"5ac51d7e6a607143d811cecb"},"response":{"categories":[]}}`))
+	})
+
+	logger := new(testLogger)
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "at").
+		SetLogger(logger).
+		SetDebug(true)
+	_, _, err := client.Venues.Categories()
+	assert.Nil(t, err)
+
+	assert.Len(t, logger.info, 1)
+	line := logger.info[0]
+	assert.Equal(t, "GET", logValue(line, "method"))
+	assert.Equal(t, "/v2/venues/categories", logValue(line, "path"))
+	assert.Equal(t, 200, logValue(line, "status"))
+	assert.Equal(t, "5ac51d7e6a607143d811cecb", logValue(line, "requestId"))
+	assert.Equal(t, 5000, logValue(line, "rateLimit"))
+	assert.Equal(t, 4998, logValue(line, "rateRemaining"))
+
+	query, _ := url.ParseQuery(logValue(line, "query").(string))
+	assert.Equal(t, redacted, query.Get("client_secret"))
+	assert.Equal(t, redacted, query.Get("access_token"))
+	assert.Equal(t, clientID, query.Get("client_id"))
+
+	assert.Len(t, logger.debug, 1)
+	assert.Contains(t, logValue(logger.debug[0], "body"), `"requestId":"5ac51d7e6a607143d811cecb"`)
+}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	errs  []error
+	ended bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.errs = append(s.errs, err) }
+func (s *testSpan) End()                                       { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+type testMetrics struct {
+	latencies map[string]int
+	errors    map[string]string
+	remaining int
+}
+
+func (m *testMetrics) ObserveLatency(ctx context.Context, endpoint string, d time.Duration) {
+	m.latencies[endpoint]++
+}
+
+func (m *testMetrics) IncError(ctx context.Context, endpoint, errorType string) {
+	m.errors[endpoint] = errorType
+}
+
+func (m *testMetrics) SetQuota(ctx context.Context, endpoint string, limit, remaining int) {
+	m.remaining = remaining
+}
+
+func TestClient_Instrument(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4998")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"meta":{"code":400,"errorType":"param_error","errorDetail":"Value 5414 is invalid for venue id","requestId":"5ac51d7e6a607143d811cecb"},"response":{}}`))
+	})
+
+	var order []string
+	tracer := new(testTracer)
+	metrics := &testMetrics{latencies: map[string]int{}, errors: map[string]string{}}
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "").
+		Use(func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, "outer "+Endpoint(req.Context()))
+				return next.RoundTrip(req)
+			})
+		}).
+		Use(Instrument(tracer, metrics))
+
+	_, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.IsType(t, &APIError{}, err)
+
+	assert.Equal(t, []string{"outer venues.details"}, order)
+
+	assert.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "venues.details", span.name)
+	assert.Equal(t, http.StatusBadRequest, span.attrs["http.status_code"])
+	assert.Equal(t, "5ac51d7e6a607143d811cecb", span.attrs["foursquare.request_id"])
+	assert.Len(t, span.errs, 1)
+	assert.True(t, span.ended)
+
+	assert.Equal(t, 1, metrics.latencies["venues.details"])
+	assert.Equal(t, "param_error", metrics.errors["venues.details"])
+	assert.Equal(t, 4998, metrics.remaining)
+}
+
+func TestReceive_decodeError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/categories", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"meta":{"code":200,"requestId":"5ac51d7e6a607143d811cecb"},"response":{"categories":"none"}}`))
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, _, err := client.Venues.Categories()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "foursquare: decoding venues.categories")
+}
+
+func TestReceive_apiErrorNotDecoded(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"meta":{"code":400,"errorType":"param_error","errorDetail":"bad"},"response":{"venue":{"id":"partial"}}}`))
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venue, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.IsType(t, &APIError{}, err)
+	assert.Equal(t, "", venue.ID)
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestClient_Instrument_readError(t *testing.T) {
+	httpClient := &http.Client{Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(errReader{}),
+			Request:    req,
+		}, nil
+	})}
+
+	tracer := new(testTracer)
+	metrics := &testMetrics{latencies: map[string]int{}, errors: map[string]string{}}
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "").
+		Use(Instrument(tracer, metrics))
+
+	_, _, err := client.Venues.Categories()
+	assert.Error(t, err)
+
+	assert.Len(t, tracer.spans, 1)
+	assert.Len(t, tracer.spans[0].errs, 1)
+	assert.Equal(t, errorTypeTransport, metrics.errors["venues.categories"])
+}
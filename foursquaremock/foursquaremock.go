@@ -0,0 +1,55 @@
+// Package foursquaremock has mocks of the foursquarego service interfaces
+// for testing code that uses a Client without an HTTP server.
+//
+//	venues := &foursquaremock.VenueAPI{
+//		DetailsFunc: func(id string) (*foursquarego.Venue, *http.Response, error) {
+//			return &foursquarego.Venue{ID: id, Name: "Joe's Pizza"}, nil, nil
+//		},
+//	}
+//	client := foursquarego.NewClientWithVenues(venues)
+//
+// The mocks are generated from the interfaces, run go generate after
+// changing them.
+package foursquaremock
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrNotSet is returned by mock methods whose Func field is nil.
+var ErrNotSet = errors.New("foursquaremock: method not set")
+
+// Call is a call made to a mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the method in order, or every call if
+// method is empty.
+func (r *recorder) Calls(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if method == "" || c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
@@ -0,0 +1,38 @@
+package foursquaremock_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaremock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVenueAPI(t *testing.T) {
+	venues := &foursquaremock.VenueAPI{
+		DetailsFunc: func(id string) (*foursquarego.Venue, *http.Response, error) {
+			return &foursquarego.Venue{ID: id, Name: "Joe's Pizza"}, nil, nil
+		},
+	}
+	client := foursquarego.NewClientWithVenues(venues)
+
+	var result foursquarego.Result
+	venue, _, err := client.Venues.WithResult(&result).Details("v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Joe's Pizza", venue.Name)
+
+	_, _, err = client.Venues.Categories()
+	assert.Equal(t, foursquaremock.ErrNotSet, err)
+
+	assert.Equal(t, []foursquaremock.Call{
+		{Method: "WithResult", Args: []interface{}{&result}},
+		{Method: "Details", Args: []interface{}{"v1"}},
+		{Method: "Categories", Args: nil},
+	}, venues.Calls(""))
+	assert.Len(t, venues.Calls("Details"), 1)
+
+	assert.NotPanics(t, func() {
+		client.SetDebug(true).Use(func(next http.RoundTripper) http.RoundTripper { return next })
+	})
+}
@@ -0,0 +1,134 @@
+//go:build ignore
+// +build ignore
+
+// gen writes a mock for every interface in ../api.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../api.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	b.WriteString("package foursquaremock\n\n")
+	b.WriteString("import (\n\t\"net/http\"\n\n\t\"github.com/peppage/foursquarego\"\n)\n\n")
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return false
+		}
+		writeMock(&b, spec.Name.Name, iface)
+		return false
+	})
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("%v\n%s", err, b.Bytes())
+	}
+	if err := ioutil.WriteFile("mock.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeMock(b *bytes.Buffer, name string, iface *ast.InterfaceType) {
+	fmt.Fprintf(b, "// %s is a mock foursquarego.%s. Set the Func field of a method to\n", name, name)
+	fmt.Fprintf(b, "// control what it returns, methods without one return ErrNotSet.\n")
+	fmt.Fprintf(b, "// Methods returning a %s return the mock itself.\n", name)
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, m := range iface.Methods.List {
+		fmt.Fprintf(b, "\t%sFunc func%s\n", m.Names[0].Name, signature(m.Type.(*ast.FuncType)))
+	}
+	b.WriteString("\n\trecorder\n}\n\n")
+	fmt.Fprintf(b, "var _ foursquarego.%s = (*%s)(nil)\n\n", name, name)
+
+	for _, m := range iface.Methods.List {
+		method := m.Names[0].Name
+		fn := m.Type.(*ast.FuncType)
+
+		var params []string
+		for _, p := range fn.Params.List {
+			for _, n := range p.Names {
+				params = append(params, n.Name)
+			}
+		}
+		args := strings.Join(params, ", ")
+
+		var zero []string
+		for _, r := range fn.Results.List {
+			switch typeString(r.Type) {
+			case "error":
+				zero = append(zero, "ErrNotSet")
+			case "foursquarego." + name:
+				zero = append(zero, "m")
+			default:
+				zero = append(zero, "nil")
+			}
+		}
+
+		fmt.Fprintf(b, "// %s records the call and calls %sFunc.\n", method, method)
+		fmt.Fprintf(b, "func (m *%s) %s%s {\n", name, method, signature(fn))
+		if args == "" {
+			fmt.Fprintf(b, "\tm.record(%q)\n", method)
+		} else {
+			fmt.Fprintf(b, "\tm.record(%q, %s)\n", method, args)
+		}
+		fmt.Fprintf(b, "\tif m.%sFunc == nil {\n\t\treturn %s\n\t}\n", method, strings.Join(zero, ", "))
+		fmt.Fprintf(b, "\treturn m.%sFunc(%s)\n}\n\n", method, args)
+	}
+}
+
+func signature(fn *ast.FuncType) string {
+	var params []string
+	for _, p := range fn.Params.List {
+		names := make([]string, len(p.Names))
+		for i, n := range p.Names {
+			names[i] = n.Name
+		}
+		params = append(params, strings.Join(names, ", ")+" "+typeString(p.Type))
+	}
+
+	var results []string
+	for _, r := range fn.Results.List {
+		results = append(results, typeString(r.Type))
+	}
+	return "(" + strings.Join(params, ", ") + ") (" + strings.Join(results, ", ") + ")"
+}
+
+// typeString prints a type qualifying the types of package foursquarego.
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return "foursquarego." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
@@ -0,0 +1,199 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package foursquaremock
+
+import (
+	"net/http"
+
+	"github.com/peppage/foursquarego"
+)
+
+// VenueAPI is a mock foursquarego.VenueAPI. Set the Func field of a method to
+// control what it returns, methods without one return ErrNotSet.
+// Methods returning a VenueAPI return the mock itself.
+type VenueAPI struct {
+	SetHeaderFunc         func(key, value string) foursquarego.VenueAPI
+	WithResultFunc        func(r *foursquarego.Result) foursquarego.VenueAPI
+	DetailsFunc           func(id string) (*foursquarego.Venue, *http.Response, error)
+	CategoriesFunc        func() ([]foursquarego.Category, *http.Response, error)
+	SearchFunc            func(params *foursquarego.VenueSearchParams) ([]foursquarego.Venue, *http.Response, error)
+	SuggestCompletionFunc func(params *foursquarego.VenueSuggestParams) ([]foursquarego.MiniVenue, *http.Response, error)
+	TrendingFunc          func(params *foursquarego.VenueTrendingParams) ([]foursquarego.Venue, *http.Response, error)
+	ExploreFunc           func(params *foursquarego.VenueExploreParams) (*foursquarego.VenueExploreResp, *http.Response, error)
+	SweepFunc             func(params *foursquarego.SweepParams) ([]foursquarego.Venue, *foursquarego.SweepCheckpoint, error)
+	PhotosFunc            func(params *foursquarego.VenuePhotosParams) (*foursquarego.PhotoGrouping, *http.Response, error)
+	EventsFunc            func(id string) (*foursquarego.Events, *http.Response, error)
+	HoursFunc             func(id string) (*foursquarego.VenueHoursResp, *http.Response, error)
+	LikesFunc             func(id string) (*foursquarego.LikesResp, *http.Response, error)
+	LinksFunc             func(id string) (*foursquarego.Links, *http.Response, error)
+	ListedFunc            func(params *foursquarego.VenueListedParams) (*foursquarego.Listed, *http.Response, error)
+	NextVenuesFunc        func(id string) ([]foursquarego.Venue, *http.Response, error)
+	MenuFunc              func(id string) (*foursquarego.MenuResp, *http.Response, error)
+	TipsFunc              func(params *foursquarego.VenueTipsParams) ([]foursquarego.Tip, *http.Response, error)
+
+	recorder
+}
+
+var _ foursquarego.VenueAPI = (*VenueAPI)(nil)
+
+// SetHeader records the call and calls SetHeaderFunc.
+func (m *VenueAPI) SetHeader(key, value string) foursquarego.VenueAPI {
+	m.record("SetHeader", key, value)
+	if m.SetHeaderFunc == nil {
+		return m
+	}
+	return m.SetHeaderFunc(key, value)
+}
+
+// WithResult records the call and calls WithResultFunc.
+func (m *VenueAPI) WithResult(r *foursquarego.Result) foursquarego.VenueAPI {
+	m.record("WithResult", r)
+	if m.WithResultFunc == nil {
+		return m
+	}
+	return m.WithResultFunc(r)
+}
+
+// Details records the call and calls DetailsFunc.
+func (m *VenueAPI) Details(id string) (*foursquarego.Venue, *http.Response, error) {
+	m.record("Details", id)
+	if m.DetailsFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.DetailsFunc(id)
+}
+
+// Categories records the call and calls CategoriesFunc.
+func (m *VenueAPI) Categories() ([]foursquarego.Category, *http.Response, error) {
+	m.record("Categories")
+	if m.CategoriesFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.CategoriesFunc()
+}
+
+// Search records the call and calls SearchFunc.
+func (m *VenueAPI) Search(params *foursquarego.VenueSearchParams) ([]foursquarego.Venue, *http.Response, error) {
+	m.record("Search", params)
+	if m.SearchFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.SearchFunc(params)
+}
+
+// SuggestCompletion records the call and calls SuggestCompletionFunc.
+func (m *VenueAPI) SuggestCompletion(params *foursquarego.VenueSuggestParams) ([]foursquarego.MiniVenue, *http.Response, error) {
+	m.record("SuggestCompletion", params)
+	if m.SuggestCompletionFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.SuggestCompletionFunc(params)
+}
+
+// Trending records the call and calls TrendingFunc.
+func (m *VenueAPI) Trending(params *foursquarego.VenueTrendingParams) ([]foursquarego.Venue, *http.Response, error) {
+	m.record("Trending", params)
+	if m.TrendingFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.TrendingFunc(params)
+}
+
+// Explore records the call and calls ExploreFunc.
+func (m *VenueAPI) Explore(params *foursquarego.VenueExploreParams) (*foursquarego.VenueExploreResp, *http.Response, error) {
+	m.record("Explore", params)
+	if m.ExploreFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.ExploreFunc(params)
+}
+
+// Sweep records the call and calls SweepFunc.
+func (m *VenueAPI) Sweep(params *foursquarego.SweepParams) ([]foursquarego.Venue, *foursquarego.SweepCheckpoint, error) {
+	m.record("Sweep", params)
+	if m.SweepFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.SweepFunc(params)
+}
+
+// Photos records the call and calls PhotosFunc.
+func (m *VenueAPI) Photos(params *foursquarego.VenuePhotosParams) (*foursquarego.PhotoGrouping, *http.Response, error) {
+	m.record("Photos", params)
+	if m.PhotosFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.PhotosFunc(params)
+}
+
+// Events records the call and calls EventsFunc.
+func (m *VenueAPI) Events(id string) (*foursquarego.Events, *http.Response, error) {
+	m.record("Events", id)
+	if m.EventsFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.EventsFunc(id)
+}
+
+// Hours records the call and calls HoursFunc.
+func (m *VenueAPI) Hours(id string) (*foursquarego.VenueHoursResp, *http.Response, error) {
+	m.record("Hours", id)
+	if m.HoursFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.HoursFunc(id)
+}
+
+// Likes records the call and calls LikesFunc.
+func (m *VenueAPI) Likes(id string) (*foursquarego.LikesResp, *http.Response, error) {
+	m.record("Likes", id)
+	if m.LikesFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.LikesFunc(id)
+}
+
+// Links records the call and calls LinksFunc.
+func (m *VenueAPI) Links(id string) (*foursquarego.Links, *http.Response, error) {
+	m.record("Links", id)
+	if m.LinksFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.LinksFunc(id)
+}
+
+// Listed records the call and calls ListedFunc.
+func (m *VenueAPI) Listed(params *foursquarego.VenueListedParams) (*foursquarego.Listed, *http.Response, error) {
+	m.record("Listed", params)
+	if m.ListedFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.ListedFunc(params)
+}
+
+// NextVenues records the call and calls NextVenuesFunc.
+func (m *VenueAPI) NextVenues(id string) ([]foursquarego.Venue, *http.Response, error) {
+	m.record("NextVenues", id)
+	if m.NextVenuesFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.NextVenuesFunc(id)
+}
+
+// Menu records the call and calls MenuFunc.
+func (m *VenueAPI) Menu(id string) (*foursquarego.MenuResp, *http.Response, error) {
+	m.record("Menu", id)
+	if m.MenuFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.MenuFunc(id)
+}
+
+// Tips records the call and calls TipsFunc.
+func (m *VenueAPI) Tips(params *foursquarego.VenueTipsParams) ([]foursquarego.Tip, *http.Response, error) {
+	m.record("Tips", params)
+	if m.TipsFunc == nil {
+		return nil, nil, ErrNotSet
+	}
+	return m.TipsFunc(params)
+}
@@ -0,0 +1,24 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package foursquaretest
+
+// fixtures are the files in json/venues keyed by name without .json.
+var fixtures = map[string]string{
+	"categories":                  "{\"meta\":{\"code\":200,\"requestId\":\"58545246f594df0687e36c62\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"categories\":[{\"id\":\"4d4b7104d754a06370d81259\",\"name\":\"Arts & Entertainment\",\"pluralName\":\"Arts & Entertainment\",\"shortName\":\"Arts & Entertainment\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/arts_entertainment\\/default_\",\"suffix\":\".png\"},\"categories\":[{\"id\":\"56aa371be4b08b9a8d5734db\",\"name\":\"Amphitheater\",\"pluralName\":\"Amphitheaters\",\"shortName\":\"Amphitheater\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/arts_entertainment\\/default_\",\"suffix\":\".png\"},\"categories\":[]}]}]}}",
+	"details":                     "{\"meta\":{\"code\":200,\"requestId\":\"5aff27a14c1f673d7d09f91e\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":5}}],\"response\":{\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\",\"name\":\"Threes Brewing\",\"contact\":{\"phone\":\"7185222110\",\"formattedPhone\":\"(718) 522-2110\",\"twitter\":\"threesbrewing\",\"instagram\":\"threesbrewing\",\"facebook\":\"1494258594141562\"},\"location\":{\"address\":\"333 Douglass St\",\"crossStreet\":\"at 4th Ave\",\"lat\":40.67979901271337,\"lng\":-73.98215935484912,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.67979901271337,\"lng\":-73.98215935484912}],\"postalCode\":\"11217\",\"cc\":\"US\",\"city\":\"Brooklyn\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"333 Douglass St (at 4th Ave)\",\"Brooklyn, NY 11217\"]},\"canonicalUrl\":\"https://foursquare.com/v/threes-brewing/5414d0a6498ea3d31a3c64cf\",\"categories\":[{\"id\":\"50327c8591d4c4b30a586d5d\",\"name\":\"Brewery\",\"pluralName\":\"Breweries\",\"shortName\":\"Brewery\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/food/brewery_\",\"suffix\":\".png\"},\"primary\":true},{\"id\":\"4bf58dd8d48988d116941735\",\"name\":\"Bar\",\"pluralName\":\"Bars\",\"shortName\":\"Bar\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/nightlife/pub_\",\"suffix\":\".png\"}}],\"verified\":true,\"stats\":{\"tipCount\":165,\"usersCount\":12756,\"checkinsCount\":15477,\"visitsCount\":25836},\"url\":\"http://www.threesbrewing.com\",\"price\":{\"tier\":2,\"message\":\"Moderate\",\"currency\":\"$\"},\"hasMenu\":true,\"likes\":{\"count\":1077,\"groups\":[{\"type\":\"others\",\"count\":1077,\"items\":[]}],\"summary\":\"1077 Likes\"},\"like\":false,\"dislike\":false,\"ok\":false,\"rating\":9.4,\"ratingColor\":\"00B551\",\"ratingSignals\":1309,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https://foursquare.com/v/vanessas-dumpling-house/49eeaf08f964a52078681fe3/menu\",\"mobileUrl\":\"https://foursquare.com/v/49eeaf08f964a52078681fe3/device_menu\"},\"allowMenuUrlEdit\":true,\"friendVisits\":{\"count\":4,\"summary\":\"You and 3 friends have been here\",\"items\":[{\"visitedCount\":2,\"liked\":false,\"disliked\":false,\"oked\":false,\"user\":{\"id\":\"68150\",\"firstName\":\"Michael\",\"lastName\":\"Peppler\",\"gender\":\"male\",\"relationship\":\"self\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/68150-NB43B0NAABATDOBQ\"}}},{\"visitedCount\":2,\"liked\":true,\"disliked\":false,\"oked\":false,\"tips\":1,\"user\":{\"id\":\"349672\",\"firstName\":\"Valerie\",\"gender\":\"female\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/349672-EFOECR1MUVSDKTYY.jpg\"}}},{\"visitedCount\":2,\"liked\":false,\"disliked\":false,\"oked\":false,\"user\":{\"id\":\"76096\",\"firstName\":\"Christian\",\"gender\":\"male\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/UJP4XTD4MHZC1AUE.jpg\"}}},{\"visitedCount\":1,\"liked\":false,\"disliked\":false,\"oked\":false,\"user\":{\"id\":\"65548\",\"firstName\":\"Adrienne\",\"lastName\":\"Stortz\",\"gender\":\"female\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4A1FOUEGNHYRDFPL.jpg\"}}}]},\"beenHere\":{\"count\":2,\"unconfirmedCount\":0,\"marked\":true,\"lastVisitedAt\":1444526165,\"lastCheckinExpiredAt\":1444536965},\"specials\":{\"count\":0,\"items\":[]},\"photos\":{\"count\":735,\"groups\":[{\"type\":\"venue\",\"name\":\"Venue photos\",\"count\":735,\"items\":[{\"id\":\"549ecb0f11d2ed4887ba35ab\",\"createdAt\":1419692815,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_78vNYkB4sZbQ23LykVYIccyi2zSkD98qo3CHkQ-vI5k.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb4111d2ed4887ba3e39\",\"createdAt\":1419692865,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_UyZ6PVFIBRiI1BEn2pZazaTHIe2Amd4wnapdyxLcc30.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb8811d2ed4887ba49e0\",\"createdAt\":1419692936,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_wtJSS6Ie9foJROFQyQX7VFWXL2z0sEBbM7shBgXe_7A.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb6c11d2ed4887ba437d\",\"createdAt\":1419692908,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_9mZGW7A2zBARNjlnxY80c3190ZDXVC-wLL7AoxiQQVs.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb8011d2ed4887ba4653\",\"createdAt\":1419692928,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_EkILTGGTQU_hADvbaZ3aZGt3phdM5-I_LFEpxA2y09M.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"557c5e2f498ef101ad762208\",\"createdAt\":1434213935,\"source\":{\"name\":\"4sq for iOS (internal)\",\"url\":\"http://developer.foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/31666_msN7RnxD2cfdVgxUwtrTw4hwk6ZYFQQVsZOFDWflQ-c.jpg\",\"width\":1439,\"height\":1920,\"demoted\":false,\"user\":{\"id\":\"31666\",\"firstName\":\"Tim\",\"lastName\":\"Julien\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NNN0TJJR4IQM2MFA.jpg\"}},\"visibility\":\"public\"}]}]},\"venuePage\":{\"id\":\"95760005\"},\"reasons\":{\"count\":1,\"items\":[{\"summary\":\"Valerie left a tip here\",\"type\":\"social\",\"reasonName\":\"friendTipReason\",\"message\":\"Valerie left a tip here\",\"target\":{\"type\":\"navigation\",\"object\":{\"id\":\"5aff27a1603d2a002c81fac1\",\"type\":\"venueTips\",\"target\":{\"type\":\"path\",\"url\":\"/venues/5414d0a6498ea3d31a3c64cf/tips\"},\"ignorable\":false}},\"count\":1}]},\"description\":\"Brewery, Bar & Event Space located in the Gowanus section of Brooklyn\",\"storeId\":\"\",\"page\":{\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"},\"tips\":{\"count\":4},\"lists\":{\"groups\":[{\"type\":\"created\",\"count\":2,\"items\":[]}]},\"homeCity\":\"Brooklyn, NY\",\"bio\":\"\",\"contact\":{}}},\"hereNow\":{\"count\":16,\"summary\":\"16 people are here\",\"groups\":[{\"type\":\"others\",\"name\":\"Other people here\",\"count\":16,\"items\":[]}]},\"createdAt\":1410650278,\"tips\":{\"count\":165,\"groups\":[{\"type\":\"self\",\"name\":\"Tips you\\u2019ve left here\",\"count\":0,\"items\":[]},{\"type\":\"friends\",\"name\":\"Tips from friends\",\"count\":1,\"items\":[{\"id\":\"5531c837498e3b4b39679c1a\",\"createdAt\":1429325879,\"text\":\"Big place and good food. And obviously good beer!\",\"type\":\"user\",\"url\":\"http://www.newyorker.com/magazine/2015/06/29/bar-tab-threes-brewing\",\"canonicalUrl\":\"https://foursquare.com/item/5531c837498e3b4b39679c1a\",\"flags\":[],\"likes\":{\"count\":0,\"groups\":[]},\"like\":false,\"logView\":true,\"agreeCount\":0,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"349672\",\"firstName\":\"Valerie\",\"gender\":\"female\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/349672-EFOECR1MUVSDKTYY.jpg\"}},\"authorInteractionType\":\"liked\"}]},{\"type\":\"following\",\"name\":\"Tips from people you follow\",\"count\":3,\"items\":[{\"id\":\"59b7f2dd829b0c4692f0b465\",\"createdAt\":1505227485,\"text\":\"This Gowanus brewpub offers a lovely patio for enjoying its own crafted beers, local brews, and a full bar. Threes almost always has an exciting food pop-up going on, too.\",\"type\":\"user\",\"url\":\"https://ny.eater.com/maps/best-outdoor-bars-drinking-nyc\",\"canonicalUrl\":\"https://foursquare.com/item/59b7f2dd829b0c4692f0b465\",\"photo\":{\"id\":\"59b7f2df47f8761788b46145\",\"createdAt\":1505227487,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/3343327_EB3v2PCBunudS9bZjyjCA9aGH6gnk21I9q9Wy7zo_d4.jpg\",\"width\":1200,\"height\":900,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/3343327_EB3v2PCBunudS9bZjyjCA9aGH6gnk21I9q9Wy7zo_d4.jpg\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":3,\"disagreeCount\":2,\"todo\":{\"count\":1},\"user\":{\"id\":\"3343327\",\"firstName\":\"Eater\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/3343327-YQ1D52V3KK2RQLBU.png\"},\"type\":\"page\"},\"authorInteractionType\":\"liked\"},{\"id\":\"559ab235498e42c9d337faab\",\"createdAt\":1436201525,\"text\":\"\\\"Threes combats short attention spans by having different restaurants occupy its kitchen for brief residencies, which, alongside the ever-changing beer menu, keeps things novel.\\\"\",\"type\":\"user\",\"url\":\"http://www.newyorker.com/magazine/2015/06/29/bar-tab-threes-brewing\",\"canonicalUrl\":\"https://foursquare.com/item/559ab235498e42c9d337faab\",\"photo\":{\"id\":\"559ab236498eb0398c37c391\",\"createdAt\":1436201526,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/2611536_sjJWUQi-qLNRpDO5s-L306EQALDd9-B9Zev1s5bjE_4.jpg\",\"width\":320,\"height\":180,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/2611536_sjJWUQi-qLNRpDO5s-L306EQALDd9-B9Zev1s5bjE_4.jpg\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":3,\"disagreeCount\":0,\"todo\":{\"count\":1},\"user\":{\"id\":\"2611536\",\"firstName\":\"The New Yorker\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2611536-ZWKHMEGCZWBA3QQY.png\"},\"type\":\"page\"},\"authorInteractionType\":\"liked\"},{\"id\":\"5531c837498e3b4b39679c1a\",\"createdAt\":1429325879,\"text\":\"Big place and good food. And obviously good beer!\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5531c837498e3b4b39679c1a\",\"flags\":[],\"likes\":{\"count\":0,\"groups\":[]},\"like\":false,\"logView\":true,\"agreeCount\":0,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"349672\",\"firstName\":\"Valerie\",\"gender\":\"female\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/349672-EFOECR1MUVSDKTYY.jpg\"}},\"authorInteractionType\":\"liked\"}]},{\"type\":\"others\",\"name\":\"All tips\",\"count\":165,\"items\":[{\"id\":\"5499f00a498e4a801263f069\",\"createdAt\":1419374602,\"text\":\"Worth the trip out towards Gowanus to check out this new brewery. Fantastic selection of rare and unique beers plus their own stock is great.  Try the sour beer with some BBQ!\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5499f00a498e4a801263f069\",\"flags\":[],\"likes\":{\"count\":6,\"groups\":[{\"type\":\"others\",\"count\":6,\"items\":[{\"id\":\"16968506\",\"firstName\":\"Geena\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/16968506-A2AVNBV1ZDWUISXU.jpg\"}},{\"id\":\"16388\",\"firstName\":\"Steven\",\"lastName\":\"van Wel\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/16388_L78pTsdK_vwcRVPxLqRLU7NrCVzPNgSIAGSGeNojkEP8IWRzkSqHIziLQN8CbQSioacW4hFK9.jpg\"}},{\"id\":\"1067005\",\"firstName\":\"Peter\",\"lastName\":\"Scordo\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/X40HPBFE40UDYV3N.jpg\"}},{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}}]}],\"summary\":\"6 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":6,\"disagreeCount\":0,\"todo\":{\"count\":2},\"user\":{\"id\":\"1027147\",\"firstName\":\"Mike\",\"lastName\":\"McBryan\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1027147-XOGBA3IN1JCZR1EG.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"56522005498ef66c730dace9\",\"createdAt\":1448222725,\"text\":\"Amazing brewery with a rotating kitchen, if you're lucky to be there when Tortilleria Nixtamal is leading the kitchen, you can enjoy some delicious Bistek, Al Pastor, Camaron, Chorizo, & Pollo Tacos.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/56522005498ef66c730dace9\",\"photo\":{\"id\":\"56522006498ed4c7c64c222e\",\"createdAt\":1448222726,\"source\":{\"name\":\"Foursquare for Android\",\"url\":\"https://foursquare.com/download/#/android\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/1939871_KG-rRb_977CquwgK_aY2nPL-IRk-fxQo8ufxKLh-1Bc.jpg\",\"width\":960,\"height\":960,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/1939871_KG-rRb_977CquwgK_aY2nPL-IRk-fxQo8ufxKLh-1Bc.jpg\",\"flags\":[],\"likes\":{\"count\":5,\"groups\":[{\"type\":\"others\",\"count\":5,\"items\":[{\"id\":\"2903254\",\"firstName\":\"Scott\",\"lastName\":\"Pestronk\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/PZTDEX2M4INF5ALO.jpg\"}},{\"id\":\"59975176\",\"firstName\":\"Christina\",\"lastName\":\"Cipriano\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/59975176-I534RSCYN01VP2YB.jpg\"}},{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}},{\"id\":\"24538930\",\"firstName\":\"Zeebamehrin\",\"lastName\":\"Manavi\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2ZDTZVMJ34BRD2OM.jpg\"}}]}],\"summary\":\"5 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":6,\"disagreeCount\":0,\"todo\":{\"count\":1},\"user\":{\"id\":\"1939871\",\"firstName\":\"DC Dining Adventures\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1939871-GKWGAJJUHEOK35WS.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"5587055e498efdcb2fcce9d4\",\"createdAt\":1434912094,\"text\":\"This place is great. Truly delicious house beers and excellent selection of guest taps. Rotating kitchen of good Brooklyn faire.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5587055e498efdcb2fcce9d4\",\"flags\":[],\"likes\":{\"count\":4,\"groups\":[{\"type\":\"others\",\"count\":4,\"items\":[{\"id\":\"59975176\",\"firstName\":\"Christina\",\"lastName\":\"Cipriano\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/59975176-I534RSCYN01VP2YB.jpg\"}},{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},{\"id\":\"43825313\",\"firstName\":\"Marlynn\",\"lastName\":\"West\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/43825313-ID0IPEKIA5B4013Y.jpg\"}},{\"id\":\"7778\",\"firstName\":\"Ryan\",\"lastName\":\"K\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/-0KC5YUYMZYV4KBYT.jpg\"}}]}],\"summary\":\"4 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":4,\"disagreeCount\":0,\"todo\":{\"count\":32},\"user\":{\"id\":\"8239957\",\"firstName\":\"Carmen\",\"lastName\":\"N\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/XBDCFU55S1CV4RY1.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"54b9b02f498e28bde6e5c3dd\",\"createdAt\":1421455407,\"text\":\"Now serving Roberta's pizza. #glory\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/54b9b02f498e28bde6e5c3dd\",\"flags\":[],\"likes\":{\"count\":3,\"groups\":[{\"type\":\"others\",\"count\":3,\"items\":[{\"id\":\"349347\",\"firstName\":\"laura\",\"lastName\":\"horak\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/IJYKJLEYQIXAZERF.jpg\"}},{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},{\"id\":\"84866\",\"firstName\":\"Jud\",\"lastName\":\"Valeski\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/84866-R31EI4IDRZL3CM42.jpg\"}}]}],\"summary\":\"3 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":3,\"disagreeCount\":0,\"todo\":{\"count\":2},\"user\":{\"id\":\"29733\",\"firstName\":\"Shawn\",\"lastName\":\"Cheng\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4P5KU31XA43DFF4E.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"558597de498e13b73e9ca05c\",\"createdAt\":1434818526,\"text\":\"Beautiful backyard patio. So many drink options: fun cocktails, good wine and both house brewed beer and local beer on draft.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/558597de498e13b73e9ca05c\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[{\"type\":\"others\",\"count\":2,\"items\":[{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},{\"id\":\"116295\",\"firstName\":\"T\",\"lastName\":\"Kiel\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/116295-UALAPMRXPDGGYVIA.jpg\"}}]}],\"summary\":\"2 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":2,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"3012579\",\"firstName\":\"Sabrina\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/3012579-UF20VI5C5TOTZ0ML.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"557c5e1b498ee9d1985ca74d\",\"createdAt\":1434213915,\"text\":\"Epic, airy vibe both inside and out. Huge bar area, outdoor patio, and sneaky ninth street espresso bk outpost tucked inside\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/557c5e1b498ee9d1985ca74d\",\"photo\":{\"id\":\"557c5e2f498ef101ad762208\",\"createdAt\":1434213935,\"source\":{\"name\":\"4sq for iOS (internal)\",\"url\":\"http://developer.foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/31666_msN7RnxD2cfdVgxUwtrTw4hwk6ZYFQQVsZOFDWflQ-c.jpg\",\"width\":1439,\"height\":1920,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/31666_msN7RnxD2cfdVgxUwtrTw4hwk6ZYFQQVsZOFDWflQ-c.jpg\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[{\"type\":\"others\",\"count\":2,\"items\":[{\"id\":\"29689102\",\"firstName\":\"Kyle\",\"lastName\":\"Bye\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NGLQ5LUNL3DUJQXK.jpg\"}},{\"id\":\"242662\",\"firstName\":\"Vineet\",\"lastName\":\"Shah\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/QKJ4W3CB3GHLUACL.jpg\"}}]}],\"summary\":\"2 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":4,\"disagreeCount\":0,\"todo\":{\"count\":2},\"user\":{\"id\":\"31666\",\"firstName\":\"Tim\",\"lastName\":\"Julien\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NNN0TJJR4IQM2MFA.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"550c6787498ef1eca78fc479\",\"createdAt\":1426876295,\"text\":\"Tons of amazing house beers on tap. Every one that I've tried has blown me away. Just had the Threes Hereyago Pale ale and as a lover of IPAs I was blown away. Vibrant, crisp with a hoppy apricot vibe\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/550c6787498ef1eca78fc479\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[{\"type\":\"others\",\"count\":2,\"items\":[{\"id\":\"7778\",\"firstName\":\"Ryan\",\"lastName\":\"K\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/-0KC5YUYMZYV4KBYT.jpg\"}}]}],\"summary\":\"2 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":2,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"49740825\",\"firstName\":\"Mike\",\"lastName\":\"McVicar\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/AIDE4MY2RXP2VGC4.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"54ab5803498ef6cba6877832\",\"createdAt\":1420515331,\"text\":\"9th st espresso has a coffee shop inside\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/54ab5803498ef6cba6877832\",\"photo\":{\"id\":\"54ab5805498ea502f48d54ab\",\"createdAt\":1420515333,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/16375_E8A5grTZ1Wb5GGoawSJ5cnO7Ru4HUNR3ilyfLcwKE3U.jpg\",\"width\":1440,\"height\":1440,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/16375_E8A5grTZ1Wb5GGoawSJ5cnO7Ru4HUNR3ilyfLcwKE3U.jpg\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[{\"type\":\"others\",\"count\":2,\"items\":[{\"id\":\"8761601\",\"firstName\":\"peter\",\"lastName\":\"kafka\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/OAUDSICRFASGPOUE.jpg\"}},{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}}]}],\"summary\":\"2 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":2,\"disagreeCount\":0,\"todo\":{\"count\":1},\"user\":{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"574c866e498ec5e41e343e9d\",\"createdAt\":1464632942,\"text\":\"They don't do tasting flights, which is a shame. Great selection though, including beers that aren't brewed by Threes. The Atomic Swerve is perfect for summer.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/574c866e498ec5e41e343e9d\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"88018\",\"firstName\":\"Petri\",\"lastName\":\"Partio\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/BW4G2L2UVAHCNVG4.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"139597870\",\"firstName\":\"Cameron\",\"lastName\":\"Gidari\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/139597870-0HFUCYQXA45MWL4C.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"5692caa3498efc71821e8c54\",\"createdAt\":1452460707,\"text\":\"Awesome brewery with a cozy industrial feel and tons of beer to choose from. They have a selection of about a half dozen of their own beers on tap plus a selection of \\u201cfriend\\u201d breweries they also have\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5692caa3498efc71821e8c54\",\"photo\":{\"id\":\"5692caad498ee1363877840f\",\"createdAt\":1452460717,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/262277_TUV2WYi8Ffa9v-NMq1veqnxh2ozGO1psr-FcEHX-Bj4.jpg\",\"width\":1440,\"height\":1920,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/262277_TUV2WYi8Ffa9v-NMq1veqnxh2ozGO1psr-FcEHX-Bj4.jpg\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"54004261\",\"firstName\":\"Jason\",\"lastName\":\"Basalyga\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/C4LRXKA5SSYOSFAA.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":6},\"user\":{\"id\":\"262277\",\"firstName\":\"Tyler\",\"lastName\":\"Lund\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/VLMCB5I5TBBDUBCN.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"5678d8d438fa6ec4acb849f9\",\"createdAt\":1450760404,\"text\":\"Everything! One of the must try breweries in NY. They have an outstanding craft selection, mixed drinks as well as rotating chefs from restaurants all over the Tristate area. Great outside seating!\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5678d8d438fa6ec4acb849f9\",\"photo\":{\"id\":\"5678d8d5498e995daee6965a\",\"createdAt\":1450760405,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/148552067_dQp-CUXUNaVVnTMnAbRpvevAei62Umhd1lBMyEz788c.jpg\",\"width\":1440,\"height\":1920,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/148552067_dQp-CUXUNaVVnTMnAbRpvevAei62Umhd1lBMyEz788c.jpg\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"148552067\",\"firstName\":\"Danny\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/148552067-5EM4KVFNRPRRLX3P.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"566cb424498e296e80e6acc1\",\"createdAt\":1449964580,\"text\":\"WiFi pass is BrewThree\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/566cb424498e296e80e6acc1\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"1222\",\"firstName\":\"Eliot\",\"lastName\":\"Shepard\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1222_1237414427.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"7940426\",\"firstName\":\"Ross\",\"lastName\":\"Berger\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/7940426-SK0XQVC2C3DC5XPM.jpg\"}}},{\"id\":\"56699e3f498ed5dd1b5ccdbb\",\"createdAt\":1449762367,\"text\":\"The change in who is doing the kitchen keeps this place interesting. Their beers are amazing, and they bring in their friends as well. It's also a perfect venue for a small party.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/56699e3f498ed5dd1b5ccdbb\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"760367\",\"firstName\":\"Robbie\",\"lastName\":\"Siron\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/760367-GUJGBEG0JFOOUBAD.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"564a9c98498e01a9d0c7fb28\",\"createdAt\":1447730328,\"text\":\"Pretty cool, but can be really packed. Protip: the bathroom doors slide open.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/564a9c98498e01a9d0c7fb28\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"41528975\",\"firstName\":\"Campbell\",\"lastName\":\"Bird\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/I0XPAQJBBPZJ0U0F.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"561d3046498e80fb321f5dd6\",\"createdAt\":1444753478,\"text\":\"Check their website beforehand to see who's in the rotating kitchen spot this week. They bring different restaurants from all over the city in to do guest shifts.\",\"type\":\"user\",\"url\":\"http://www.threesbrewing.com/\",\"canonicalUrl\":\"https://foursquare.com/item/561d3046498e80fb321f5dd6\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"29689102\",\"firstName\":\"Kyle\",\"lastName\":\"Bye\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NGLQ5LUNL3DUJQXK.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":2,\"disagreeCount\":0,\"todo\":{\"count\":1},\"user\":{\"id\":\"46926\",\"firstName\":\"Zack\",\"lastName\":\"Sheppard\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/46926-XY23IKEYBTHMA05U.jpg\"}},\"authorInteractionType\":\"liked\"},{\"id\":\"560cacfc498e59e51434ea76\",\"createdAt\":1443671292,\"text\":\"Try \\\"I Hate Myself\\\" if you're looking to drink an IPA 😎\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/560cacfc498e59e51434ea76\",\"photo\":{\"id\":\"560cacfe498e9570733abbb3\",\"createdAt\":1443671294,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/31472_Dj-Zw0-A2-hlHiAhXPRW1YhcLm9EaaCpSievR-de6kw.jpg\",\"width\":1439,\"height\":1920,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https://igx.4sqi.net/img/general/original/31472_Dj-Zw0-A2-hlHiAhXPRW1YhcLm9EaaCpSievR-de6kw.jpg\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"31472\",\"firstName\":\"Emily\",\"lastName\":\"Wilson\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/31472-J3VPBIQ1WXYG1UB0.jpg\"}},\"authorInteractionType\":\"liked\"}]}]},\"shortUrl\":\"http://4sq.com/1qxRLL3\",\"timeZone\":\"America/New_York\",\"listed\":{\"count\":1091,\"groups\":[{\"type\":\"others\",\"name\":\"Lists from other people\",\"count\":1091,\"items\":[{\"id\":\"57757f23498e8e90405a5cd9\",\"name\":\"20 Great Spots for a Summer Beer in NYC\",\"description\":\"Summer heat got you strugglin'? A cold, flavorful brew goes a long way. Make your way to one of these 20 NYC bars, breweries, and pubs for some high quality refreshment.\",\"type\":\"others\",\"user\":{\"id\":\"23438729\",\"firstName\":\"Foursquare City Guide\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/23438729-TODURDIUDUMY4JF5.png\"},\"type\":\"page\"},\"editable\":false,\"public\":true,\"collaborative\":false,\"url\":\"/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc\",\"canonicalUrl\":\"https://foursquare.com/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc\",\"createdAt\":1467318051,\"updatedAt\":1467401782,\"photo\":{\"id\":\"50f8cf84e4b03d59633b0e58\",\"createdAt\":1358483332,\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/38623014_tEqBPImUZNkxXxmTqNpfQVgCWEJ3tIM-Ednee3PS-8o.jpg\",\"width\":717,\"height\":959,\"demoted\":false,\"user\":{\"id\":\"38623014\",\"firstName\":\"Deborah\",\"lastName\":\"Navarra\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/N34C4M2HBGVPPKO5.jpg\"}},\"visibility\":\"public\"},\"logView\":true,\"guideType\":\"bestOf\",\"guide\":true,\"followers\":{\"count\":98},\"listItems\":{\"count\":20,\"items\":[{\"id\":\"t5692caa3498efc71821e8c54\",\"createdAt\":1467319289,\"tip\":{\"id\":\"5692caa3498efc71821e8c54\",\"createdAt\":1452460707,\"text\":\"Awesome brewery with a cozy industrial feel and tons of beer to choose from. They have a selection of about a half dozen of their own beers on tap plus a selection of \\u201cfriend\\u201d breweries they also have\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/5692caa3498efc71821e8c54\",\"flags\":[],\"likes\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"count\":1,\"items\":[{\"id\":\"54004261\",\"firstName\":\"Jason\",\"lastName\":\"Basalyga\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/C4LRXKA5SSYOSFAA.jpg\"}}]}],\"summary\":\"1 like\"},\"like\":false,\"logView\":true,\"agreeCount\":1,\"disagreeCount\":0,\"todo\":{\"count\":6},\"saves\":{},\"user\":{\"id\":\"262277\",\"firstName\":\"Tyler\",\"lastName\":\"Lund\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/VLMCB5I5TBBDUBCN.jpg\"}}},\"photo\":{\"id\":\"549ecb4111d2ed4887ba3e39\",\"createdAt\":1419692865,\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_UyZ6PVFIBRiI1BEn2pZazaTHIe2Amd4wnapdyxLcc30.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"}}]}},{\"id\":\"516470abe4b07472e250461c\",\"name\":\"Bars with Outdoor Space\",\"description\":\"\",\"type\":\"others\",\"user\":{\"id\":\"10407371\",\"firstName\":\"Adela\",\"lastName\":\"Mou\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/10407371_nd6JYk8C_8u4MChdkXlT0GK0tRubSBqGLDX3DQxxPYFsKzxMCxeBKcy2MHLjLv_fxrzgdsxYl.jpg\"}},\"editable\":false,\"public\":true,\"collaborative\":false,\"url\":\"/user/10407371/list/bars-with-outdoor-space\",\"canonicalUrl\":\"https://foursquare.com/user/10407371/list/bars-with-outdoor-space\",\"createdAt\":1365536939,\"updatedAt\":1526478338,\"photo\":{\"id\":\"4e50104bb61cf637a4fe59e7\",\"createdAt\":1313869899,\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/H4YUQKZ3FUMSLSMTXB3Q3X2SN3LOPXRYAAEXHBLK551SOF2E.jpg\",\"width\":720,\"height\":431,\"demoted\":false,\"user\":{\"id\":\"130212\",\"firstName\":\"Ogun\",\"lastName\":\"Holder\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/ZKCFOLO3QGVK1DF2.jpg\"}},\"visibility\":\"public\"},\"followers\":{\"count\":124},\"listItems\":{\"count\":144,\"items\":[{\"id\":\"v5414d0a6498ea3d31a3c64cf\",\"createdAt\":1463686723}]}}]}]},\"phrases\":[{\"phrase\":\"rotating kitchen\",\"sample\":{\"entities\":[{\"indices\":[18,34],\"type\":\"keyPhrase\"}],\"text\":\"... and the food. Rotating kitchen; often great. Outdoor seating is...\"},\"count\":6},{\"phrase\":\"saison\",\"sample\":{\"entities\":[{\"indices\":[26,32],\"type\":\"keyPhrase\"}],\"text\":\"... beer selection. Their saison is probably my new favorite saison.\"},\"count\":9},{\"phrase\":\"backyard\",\"sample\":{\"entities\":[{\"indices\":[27,35],\"type\":\"keyPhrase\"}],\"text\":\"... food lineup, beautiful backyard space with tons of seating, and lots of...\"},\"count\":14}],\"hours\":{\"status\":\"Open until 2:00 AM\",\"richStatus\":{\"entities\":[],\"text\":\"Open until 2:00 AM\"},\"isOpen\":true,\"isLocalHoliday\":false,\"dayData\":[],\"timeframes\":[{\"days\":\"Mon\\u2013Tue\",\"includesToday\":true,\"open\":[{\"renderedTime\":\"5:00 PM\\u2013Midnight\"}],\"segments\":[]},{\"days\":\"Wed\\u2013Thu\",\"open\":[{\"renderedTime\":\"5:00 PM\\u20132:00 AM\"}],\"segments\":[]},{\"days\":\"Fri\",\"includesToday\":true,\"open\":[{\"renderedTime\":\"3:00 PM\\u20132:00 AM\"}],\"segments\":[]},{\"days\":\"Sat\",\"open\":[{\"renderedTime\":\"Noon\\u20132:00 AM\"}],\"segments\":[]},{\"days\":\"Sun\",\"open\":[{\"renderedTime\":\"Noon\\u2013Midnight\"}],\"segments\":[]}]},\"popular\":{\"isOpen\":false,\"isLocalHoliday\":false,\"timeframes\":[{\"days\":\"Today\",\"includesToday\":true,\"open\":[{\"renderedTime\":\"5:00 PM\\u2013Midnight\"}],\"segments\":[]},{\"days\":\"Sat\",\"open\":[{\"renderedTime\":\"Noon\\u20131:00 AM\"}],\"segments\":[]},{\"days\":\"Sun\",\"open\":[{\"renderedTime\":\"Noon\\u20139:00 PM\"}],\"segments\":[]},{\"days\":\"Mon\",\"open\":[{\"renderedTime\":\"6:00 PM\\u20139:00 PM\"}],\"segments\":[]},{\"days\":\"Tue\\u2013Wed\",\"open\":[{\"renderedTime\":\"6:00 PM\\u201310:00 PM\"}],\"segments\":[]},{\"days\":\"Thu\",\"open\":[{\"renderedTime\":\"6:00 PM\\u201311:00 PM\"}],\"segments\":[]}]},\"pageUpdates\":{\"count\":4,\"items\":[]},\"inbox\":{\"count\":0,\"items\":[]},\"venueChains\":[],\"attributes\":{\"groups\":[{\"type\":\"price\",\"name\":\"Price\",\"summary\":\"$$\",\"count\":1,\"items\":[{\"displayName\":\"Price\",\"displayValue\":\"$$\",\"priceTier\":2}]},{\"type\":\"payments\",\"name\":\"Credit Cards\",\"summary\":\"Credit Cards\",\"count\":7,\"items\":[{\"displayName\":\"Credit Cards\",\"displayValue\":\"Yes (incl. American Express & MasterCard)\"}]},{\"type\":\"outdoorSeating\",\"name\":\"Outdoor Seating\",\"summary\":\"Outdoor Seating\",\"count\":1,\"items\":[{\"displayName\":\"Outdoor Seating\",\"displayValue\":\"Yes\"}]},{\"type\":\"music\",\"name\":\"Music\",\"summary\":\"Live Music\",\"count\":3,\"items\":[{\"displayName\":\"Live Music\",\"displayValue\":\"Live Music\"}]},{\"type\":\"wifi\",\"name\":\"Wi-Fi\",\"summary\":\"Free Wi-Fi\",\"count\":1,\"items\":[{\"displayName\":\"Wi-Fi\",\"displayValue\":\"Free\"}]},{\"type\":\"serves\",\"name\":\"Menus\",\"summary\":\"Happy Hour, Dinner & more\",\"count\":8,\"items\":[{\"displayName\":\"Brunch\",\"displayValue\":\"Brunch\"},{\"displayName\":\"Dinner\",\"displayValue\":\"Dinner\"},{\"displayName\":\"Happy Hour\",\"displayValue\":\"Happy Hour\"}]},{\"type\":\"drinks\",\"name\":\"Drinks\",\"summary\":\"Beer, Wine, Full Bar & Cocktails\",\"count\":5,\"items\":[{\"displayName\":\"Beer\",\"displayValue\":\"Beer\"},{\"displayName\":\"Wine\",\"displayValue\":\"Wine\"},{\"displayName\":\"Full Bar\",\"displayValue\":\"Full Bar\"},{\"displayName\":\"Cocktails\",\"displayValue\":\"Cocktails\"}]}]},\"bestPhoto\":{\"id\":\"549ecb0f11d2ed4887ba35ab\",\"createdAt\":1419692815,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_78vNYkB4sZbQ23LykVYIccyi2zSkD98qo3CHkQ-vI5k.jpg\",\"width\":870,\"height\":580,\"visibility\":\"public\"},\"colors\":{\"highlightColor\":{\"photoId\":\"549ecb0f11d2ed4887ba35ab\",\"value\":-14673896},\"highlightTextColor\":{\"photoId\":\"549ecb0f11d2ed4887ba35ab\",\"value\":-1},\"algoVersion\":3}}}}",
+	"details_populated_synthetic": "{\"meta\":{\"code\":200,\"requestId\":\"5b0d6a1f9fb6b7002c3a1e2d\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":0}}],\"response\":{\"venue\":{\"id\":\"4b1c3bd2f964a5206f0424e3\",\"name\":\"Starbucks\",\"location\":{\"address\":\"1585 Broadway\",\"crossStreet\":\"at W 47th St\",\"lat\":40.760051,\"lng\":-73.984942,\"postalCode\":\"10036\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"1585 Broadway (at W 47th St)\",\"New York, NY 10036\"]},\"specials\":{\"count\":1,\"items\":[{\"id\":\"5a98a4c1d8b8e3e77a1a6d41\",\"type\":\"frequency\",\"message\":\"Check in 3 times for a free pastry\",\"description\":\"Loyalty special\",\"finePrint\":\"One per customer per day.\",\"unlocked\":false,\"icon\":\"frequency\",\"title\":\"Loyalty Special\",\"state\":\"in progress\",\"progress\":1,\"progressDescription\":\"2 more check-ins to unlock\",\"detail\":\"Show this screen to the barista\",\"target\":3,\"provider\":\"foursquare\",\"redemption\":\"standard\"}]},\"hereNow\":{\"count\":1,\"summary\":\"One other person is here\",\"groups\":[{\"type\":\"others\",\"name\":\"Other people here\",\"count\":1,\"items\":[{\"id\":\"5b0d69e2b04f4a002c8e7f10\",\"createdAt\":1527605730,\"type\":\"checkin\",\"shout\":\"Morning fuel\",\"timeZoneOffset\":-240,\"user\":{\"id\":\"1227451\",\"firstName\":\"Ana\",\"lastName\":\"S.\",\"gender\":\"female\"}}]}]},\"tips\":{\"count\":1,\"groups\":[{\"type\":\"others\",\"name\":\"All tips\",\"count\":1,\"items\":[{\"id\":\"4e2d1f3c1838f1c5529bd4a7\",\"createdAt\":1311580988,\"text\":\"Upstairs is much quieter.\",\"type\":\"user\",\"flags\":[\"spam\"],\"agreeCount\":4,\"disagreeCount\":0}]}]},\"hours\":{\"status\":\"Open until 10:00 PM\",\"isOpen\":true,\"isLocalHoliday\":false,\"timeframes\":[{\"days\":\"Mon–Fri\",\"includesToday\":true,\"open\":[{\"renderedTime\":\"5:30 AM–10:00 PM\"}],\"segments\":[{\"label\":\"Happy Hour\",\"renderedTime\":\"3:00 PM–5:00 PM\"}]}]},\"pageUpdates\":{\"count\":1,\"items\":[{\"id\":\"5ae8b4f5a4b5c3002c0f1e21\",\"createdAt\":1525200117,\"text\":\"Our summer drinks are back!\",\"page\":{\"id\":\"34164143\",\"firstName\":\"Starbucks\",\"type\":\"chain\"},\"photos\":{\"count\":0,\"groups\":[]},\"likes\":{\"count\":12,\"groups\":[],\"summary\":\"12 likes\"},\"like\":false}]},\"inbox\":{\"count\":1,\"items\":[{\"id\":\"5ae8b4f5a4b5c3002c0f1e21\",\"createdAt\":1525200117,\"text\":\"Our summer drinks are back!\",\"page\":{\"id\":\"34164143\",\"firstName\":\"Starbucks\",\"type\":\"chain\"},\"like\":false}]},\"venueChains\":[{\"id\":\"556f676fbd6a75a99038d8ec\"}]}}}",
+	"events":                      "{\"meta\":{\"code\":200,\"requestId\":\"585309f89fb6b7213f947901\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":10}}],\"response\":{\"events\":{\"count\":26,\"summary\":\"26 movies\",\"items\":[{\"id\":\"580850f7d67c37ceeeaae676\",\"name\":\"Moonlight\",\"categories\":[{\"id\":\"4dfb90c6bd413dd705e8f897\",\"name\":\"Movie\",\"pluralName\":\"Movies\",\"shortName\":\"Movie\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/arts_entertainment/movietheater_\",\"suffix\":\".png\"},\"primary\":true}],\"hereNow\":{\"count\":0,\"groups\":[{\"type\":\"friends\",\"name\":\"Friends here\",\"count\":0,\"items\":[]},{\"type\":\"others\",\"name\":\"Other people here\",\"count\":0,\"items\":[]}]},\"allDay\":true,\"startAt\":1526675883,\"endAt\":1526848682,\"date\":1477540800,\"timeZone\":\"America/New_York\",\"stats\":{\"checkinsCount\":81,\"usersCount\":78},\"url\":\"https://foursquare.com/events/movies?theater=AAORE&movie=194816&wired=true\"}]}}}",
+	"explore":                     "{\"meta\":{\"code\":200,\"requestId\":\"5859aead4434b945074b662c\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"suggestedFilters\":{\"header\":\"Tap to show:\",\"filters\":[{\"name\":\"$-$$$$\",\"key\":\"price\"},{\"name\":\"I haven't been\",\"key\":\"novelty\"}]},\"warning\":{\"text\":\"There aren't a lot of results near you. Try something more general, reset your filters, or expand the search area.\"},\"suggestedRadius\":600,\"headerLocation\":\"Theater District\",\"headerFullLocation\":\"Theater District, New York\",\"headerLocationGranularity\":\"neighborhood\",\"totalResults\":234,\"suggestedBounds\":{\"ne\":{\"lat\":40.765735484141466,\"lng\":-73.98070944232728},\"sw\":{\"lat\":40.76303582435602,\"lng\":-73.97800190124289}},\"groups\":[{\"type\":\"Recommended Places\",\"name\":\"recommended\",\"items\":[{\"reasons\":{\"count\":2,\"items\":[{\"summary\":\"On: Time Out New York's Best of NYC 2011\",\"type\":\"social\",\"reasonName\":\"combinationOfListsReason\",\"message\":\"On: Time Out New York's Best of NYC 2011\"}]},\"venue\":{\"id\":\"3fd66200f964a520b6e71ee3\",\"name\":\"Carnegie Hall\",\"contact\":{\"phone\":\"2122477800\",\"formattedPhone\":\"(212) 247-7800\",\"twitter\":\"carnegiehall\",\"facebook\":\"17168992485\",\"facebookUsername\":\"carnegiehall\",\"facebookName\":\"Carnegie Hall\"},\"location\":{\"address\":\"881 7th Ave\",\"crossStreet\":\"at W 57th St\",\"lat\":40.76536464962491,\"lng\":-73.97992385801638,\"distance\":38,\"postalCode\":\"10019\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"881 7th Ave (at W 57th St)\",\"New York, NY 10019\"]},\"categories\":[{\"id\":\"5032792091d4c4b30a586d5c\",\"name\":\"Concert Hall\",\"pluralName\":\"Concert Halls\",\"shortName\":\"Concert Hall\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/arts_entertainment\\/musicvenue_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":30945,\"usersCount\":21600,\"tipCount\":105},\"url\":\"http:\\/\\/www.carnegiehall.org\",\"rating\":9.5,\"ratingColor\":\"00B551\",\"ratingSignals\":1164,\"allowMenuUrlEdit\":true,\"beenHere\":{\"count\":11,\"marked\":true,\"lastCheckinExpiredAt\":0},\"hours\":{\"isOpen\":false,\"isLocalHoliday\":false},\"photos\":{\"count\":0,\"groups\":[]},\"hereNow\":{\"count\":0,\"summary\":\"Nobody here\",\"groups\":[]}},\"tips\":[{\"id\":\"4bf6db7870c603bbab6a9cb4\",\"createdAt\":1274469240,\"text\":\"Construction began in 1890 and it was named after Andrew Carnegie, who paid for its construction. It opened May 5 1891 with a concert conducted by Walter Damrosch & composer Peter Ilyich Tchaikovsky\",\"type\":\"user\",\"canonicalUrl\":\"https:\\/\\/foursquare.com\\/item\\/4bf6db7870c603bbab6a9cb4\",\"flags\":[],\"likes\":{\"count\":25,\"groups\":[],\"summary\":\"25 likes\"},\"logView\":true,\"agreeCount\":24,\"disagreeCount\":0,\"todo\":{\"count\":440},\"user\":{\"id\":\"376887\",\"firstName\":\"HISTORY\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https:\\/\\/irs3.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/376887-4KMRMDF4C2IGL1QX.png\"},\"type\":\"chain\"}}],\"referralId\":\"e-0-3fd66200f964a520b6e71ee3-0\"},{\"reasons\":{\"count\":0,\"items\":[{\"summary\":\"This spot is popular\",\"type\":\"general\",\"reasonName\":\"globalInteractionReason\"}]},\"venue\":{\"id\":\"3fd66200f964a520d7ea1ee3\",\"name\":\"Estiatorio Milos\",\"contact\":{\"phone\":\"2122457400\",\"formattedPhone\":\"(212) 245-7400\",\"facebook\":\"111705865534012\",\"facebookUsername\":\"estiatoriomilosnyc\",\"facebookName\":\"Estiatorio Milos NYC\"},\"location\":{\"address\":\"125 W 55th St\",\"crossStreet\":\"btwn 6th and 7th Ave.\",\"lat\":40.76340665887258,\"lng\":-73.97916053786035,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.76340665887258,\"lng\":-73.97916053786035}],\"distance\":192,\"postalCode\":\"10019\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"125 W 55th St (btwn 6th and 7th Ave.)\",\"New York, NY 10019\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d10e941735\",\"name\":\"Greek Restaurant\",\"pluralName\":\"Greek Restaurants\",\"shortName\":\"Greek\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/greek_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":false,\"stats\":{\"checkinsCount\":5714,\"usersCount\":4302,\"tipCount\":115},\"url\":\"http:\\/\\/www.milos.ca\\/\",\"price\":{\"tier\":4,\"message\":\"Very Expensive\",\"currency\":\"$\"},\"hasMenu\":true,\"rating\":9.3,\"ratingColor\":\"00B551\",\"ratingSignals\":373,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/estiatorio-milos\\/3fd66200f964a520d7ea1ee3\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/3fd66200f964a520d7ea1ee3\\/device_menu\",\"externalUrl\":\"http:\\/\\/milos.ca\\/restaurants\\/new-york\\/menus\\/lunch--2\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"count\":0,\"marked\":false,\"lastCheckinExpiredAt\":0},\"hours\":{\"status\":\"Open until 11:30 PM\",\"isOpen\":true,\"isLocalHoliday\":false},\"photos\":{\"count\":0,\"groups\":[]},\"hereNow\":{\"count\":2,\"summary\":\"2 people are here\",\"groups\":[{\"type\":\"others\",\"name\":\"Other people here\",\"count\":2,\"items\":[]}]}},\"tips\":[{\"id\":\"50f05aace4b0c0b860b3f010\",\"createdAt\":1357929132,\"text\":\"The zucchini chips are to die for!\",\"type\":\"user\",\"canonicalUrl\":\"https:\\/\\/foursquare.com\\/item\\/50f05aace4b0c0b860b3f010\",\"flags\":[],\"likes\":{\"count\":6,\"groups\":[],\"summary\":\"6 likes\"},\"logView\":true,\"agreeCount\":6,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"19798\",\"firstName\":\"Peter\",\"lastName\":\"Shankman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https:\\/\\/irs1.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/4a5c8f17e946e.jpg\"}}}],\"referralId\":\"e-0-3fd66200f964a520d7ea1ee3-1\"},{\"reasons\":{\"count\":0,\"items\":[{\"summary\":\"This spot is popular\",\"type\":\"general\",\"reasonName\":\"globalInteractionReason\"}]},\"venue\":{\"id\":\"56c55e43cd105142e6f95b94\",\"name\":\"Indian Accent\",\"contact\":{\"phone\":\"2128428070\",\"formattedPhone\":\"(212) 842-8070\",\"twitter\":\"indianaccentnyc\",\"facebook\":\"825779510839635\",\"facebookUsername\":\"IndianAccentNYC\",\"facebookName\":\"Indian Accent NYC\"},\"location\":{\"address\":\"123 W 56th St\",\"lat\":40.76422134626763,\"lng\":-73.9787874855538,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.76422134626763,\"lng\":-73.9787874855538}],\"distance\":134,\"postalCode\":\"10019\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"123 W 56th St\",\"New York, NY 10019\"]},\"categories\":[{\"id\":\"54135bf5e4b08f3d2429dfdd\",\"name\":\"North Indian Restaurant\",\"pluralName\":\"North Indian Restaurants\",\"shortName\":\"North Indian\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/default_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":471,\"usersCount\":398,\"tipCount\":23},\"url\":\"http:\\/\\/indianaccent.com\",\"rating\":9.2,\"ratingColor\":\"00B551\",\"ratingSignals\":92,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"http:\\/\\/indianaccent.com\\/newyork\\/index.html#menuID\",\"mobileUrl\":\"http:\\/\\/indianaccent.com\\/newyork\\/index.html#menuID\",\"externalUrl\":\"http:\\/\\/indianaccent.com\\/newyork\\/index.html#menuID\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"count\":0,\"marked\":false,\"lastCheckinExpiredAt\":0},\"hours\":{\"status\":\"Open until 10:30 PM\",\"isOpen\":true,\"isLocalHoliday\":false},\"photos\":{\"count\":0,\"groups\":[]},\"venuePage\":{\"id\":\"219733048\"},\"hereNow\":{\"count\":0,\"summary\":\"Nobody here\",\"groups\":[]}},\"tips\":[{\"id\":\"56ce8d4f498e15716683d475\",\"createdAt\":1456377167,\"text\":\"This place is Michelin star worthy! Beautiful presentation and delicious food. Go for chefs tasting, order the pathar beef kebab, duck chettinad, sweet pick rib, dal ghost and butter chicken naan.\",\"type\":\"user\",\"canonicalUrl\":\"https:\\/\\/foursquare.com\\/item\\/56ce8d4f498e15716683d475\",\"photo\":{\"id\":\"56ce8d50cd106ae44c3ea460\",\"createdAt\":1456377168,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https:\\/\\/foursquare.com\\/download\\/#\\/iphone\"},\"prefix\":\"https:\\/\\/irs0.4sqi.net\\/img\\/general\\/\",\"suffix\":\"\\/52807352_IJ7S0vmF-0p2WwnsUj2TTFWpGI7PGdizD-Jm7ANUHh8.jpg\",\"width\":1080,\"height\":1920,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https:\\/\\/irs0.4sqi.net\\/img\\/general\\/original\\/52807352_IJ7S0vmF-0p2WwnsUj2TTFWpGI7PGdizD-Jm7ANUHh8.jpg\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[],\"summary\":\"2 likes\"},\"logView\":true,\"agreeCount\":2,\"disagreeCount\":0,\"todo\":{\"count\":2},\"user\":{\"id\":\"52807352\",\"firstName\":\"Rewan\",\"lastName\":\"Parti\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https:\\/\\/irs2.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/52807352-5VJ5KH54BNQ2EOMN.jpg\"}}}],\"referralId\":\"e-0-56c55e43cd105142e6f95b94-2\"}]}]}}",
+	"hours":                       "{\"meta\":{\"code\":200,\"requestId\":\"5aff3aa34c1f673d7d90b4bd\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":5}}],\"response\":{\"hours\":{\"timeframes\":[{\"days\":[1,2,3,4,5,6,7],\"includesToday\":true,\"open\":[{\"start\":\"0800\",\"end\":\"+0200\"}],\"segments\":[]}]},\"popular\":{\"timeframes\":[{\"days\":[5],\"includesToday\":true,\"open\":[{\"start\":\"0800\",\"end\":\"2100\"}],\"segments\":[]},{\"days\":[6],\"open\":[{\"start\":\"1000\",\"end\":\"2300\"}],\"segments\":[]},{\"days\":[7],\"open\":[{\"start\":\"1100\",\"end\":\"2000\"}],\"segments\":[]},{\"days\":[1],\"open\":[{\"start\":\"0800\",\"end\":\"2000\"}],\"segments\":[]},{\"days\":[2],\"open\":[{\"start\":\"0800\",\"end\":\"1900\"}],\"segments\":[]},{\"days\":[3],\"open\":[{\"start\":\"0800\",\"end\":\"2000\"}],\"segments\":[]},{\"days\":[4],\"open\":[{\"start\":\"0800\",\"end\":\"2100\"}],\"segments\":[]}]}}}",
+	"likes":                       "{\"meta\":{\"code\":200,\"requestId\":\"5aff3b641ed2192af8a3829e\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":5}}],\"response\":{\"likes\":{\"count\":1077,\"summary\":\"1077 Likes\",\"items\":[{\"id\":\"349672\",\"firstName\":\"Valerie\",\"lastName\":\"K.\",\"gender\":\"female\",\"relationship\":\"friend\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/349672-EFOECR1MUVSDKTYY.jpg\"}},{\"id\":\"2693821\",\"firstName\":\"Brigette\",\"lastName\":\"K.\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2693821_U9uSozAK_mSQfkFQb6ALq0ay-GZlsAqP-Ro9Ah9nKgPxIPqah79V5kbEasLlj7ZSm1qXVZobO.jpg\"}},{\"id\":\"17998406\",\"firstName\":\"Samantha\",\"lastName\":\"MacKenzie\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/17998406_tzCXZUNL_LLDFNv1TzxIV-pVuJhp-XC7Ui4DANdaAGzmd3GuFIPfluM7XDeG7r7oBBX-_Yylv.jpg\"}},{\"id\":\"4930004\",\"firstName\":\"Julie\",\"lastName\":\"R.\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4930004-0RHKH1MLAB4DIYDZ.jpg\"}}]},\"like\":false}}",
+	"links":                       "{\"meta\":{\"code\":200,\"requestId\":\"58532a814c1f675623de9216\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":10}}],\"response\":{\"links\":{\"count\":11,\"items\":[{\"provider\":{\"id\":\"nyt\"},\"linkedId\":\"1002207971611\",\"url\":\"http:\\/\\/www.nytimes.com\\/restaurants\\/1002207971611\\/db-bistro-moderne\\/details.html\"},{\"provider\":{\"id\":\"menupages\"},\"linkedId\":\"4545\",\"url\":\"http:\\/\\/www.menupages.com\\/restaurants\\/db-bistro-moderne\\/\"},{\"provider\":{\"id\":\"nymag\"},\"linkedId\":\"444\",\"url\":\"http:\\/\\/nymag.com\\/listings\\/restaurant\\/db-bistro-moderne\\/index.html\"},{\"provider\":{\"id\":\"metromix\"},\"linkedId\":\"57068\",\"url\":\"http:\\/\\/newyork.metromix.com\\/restaurants\\/venue\\/db-bistro-moderne-midtown-west\\/57068\\/content\"},{\"provider\":{\"id\":\"voiceplaces\"},\"linkedId\":\"4247874\",\"url\":\"http:\\/\\/www.voiceplaces.com\\/db-bistro-moderne-voice-places-4247874-l\\/\"},{\"provider\":{\"id\":\"allmenu\"},\"linkedId\":\"2954\",\"url\":\"http:\\/\\/www.allmenus.com\\/ny\\/new-york\\/2954-db-bistro-moderne\\/\"},{\"provider\":{\"id\":\"singleplatform\"},\"linkedId\":\"db-bistro-moderne\"},{\"provider\":{\"id\":\"zagat\"},\"linkedId\":\"50963\"},{\"provider\":{\"id\":\"factual\"},\"linkedId\":\"a94e2546-b054-442c-b380-5f8a7fe158e3\",\"url\":\"http:\\/\\/www.danielnyc.com\\/\"},{\"provider\":{\"id\":\"opentable\"},\"linkedId\":\"1528\"},{\"provider\":{\"id\":\"locu\"},\"linkedId\":\"4a6def0a1d8ba01e79df\"}]}}}",
+	"listed":                      "{\"meta\":{\"code\":200,\"requestId\":\"58546b0add579715a8942981\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"lists\":{\"count\":382,\"groups\":[{\"type\":\"others\",\"name\":\"Lists from other people\",\"count\":382,\"items\":[{\"id\":\"561e72cf498ee3be0c697a9a\",\"name\":\"America's Best Breweries\",\"description\":\"We took a look at our data to pick the best breweries in each state (plus some other standouts). Are you crafty enough to hit up all 75?\",\"type\":\"others\",\"user\":{\"id\":\"23438729\",\"firstName\":\"Foursquare City Guide\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https:\\/\\/irs2.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/23438729-TODURDIUDUMY4JF5.png\"},\"type\":\"chain\"},\"editable\":false,\"public\":true,\"collaborative\":false,\"url\":\"\\/foursquare\\/list\\/americas-best-breweries\",\"canonicalUrl\":\"https:\\/\\/foursquare.com\\/foursquare\\/list\\/americas-best-breweries\",\"createdAt\":1444836047,\"updatedAt\":1444838394,\"photo\":{\"id\":\"5196f074498e730767614a5b\",\"createdAt\":1368846452,\"prefix\":\"https:\\/\\/irs1.4sqi.net\\/img\\/general\\/\",\"suffix\":\"\\/3600455_8l3L6befTlvsTAJLppaH80KLbOxIUJGcZWytZDB-XbI\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"3600455\",\"firstName\":\"Jeremy\",\"lastName\":\"Ong\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https:\\/\\/irs1.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/QH4TMTOZ3FGZRNTU.jpg\"}},\"visibility\":\"public\"},\"logView\":true,\"guideType\":\"bestOf\",\"guide\":true,\"followers\":{\"count\":275},\"listItems\":{\"count\":75,\"items\":[{\"id\":\"v4f68de6bd5fbee32e5f4f3a5\",\"createdAt\":1444837409,\"photo\":{\"id\":\"516aea6be4b04a22d728b512\",\"createdAt\":1365961323,\"prefix\":\"https:\\/\\/irs3.4sqi.net\\/img\\/general\\/\",\"suffix\":\"\\/9126301_LKu-eeW_K-DCgmU-P4wkHEuntM1G_ok7wxU37QedSLI.jpg\",\"width\":612,\"height\":612,\"demoted\":false,\"user\":{\"id\":\"9126301\",\"firstName\":\"Jon\",\"lastName\":\"Moore\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https:\\/\\/irs3.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/2WZSOBG221A001IB.jpg\"}},\"visibility\":\"public\"}}]}}]}]}}}",
+	"menu":                        "{\"meta\":{\"code\":200,\"requestId\":\"58586dfb351e3d53181523bb\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"menu\":{\"provider\":{\"name\":\"singleplatform\",\"attributionImage\":\"https:\\/\\/as.singleplatform.com\\/Foursquare\\/mission-chinese-food-0\\/provided_by.png\",\"attributionLink\":\"http:\\/\\/w.singlepage.com\\/mission-chinese-food-0\\/menu?ref=Foursquare\",\"attributionText\":\"Disclaimer: Always check with the business for pricing and availability of menu items. SinglePlatform is not responsible for menu or pricing changes, but the information is believed to be accurate when posted. Listing of a menu does not mean that there is any affiliation, endorsement or sponsorship between SinglePlatform and the listed business.\"},\"menus\":{\"count\":1,\"items\":[{\"menuId\":\"m4bgurrarjo79z0xiwb2iy5rt\",\"name\":\"Main Menu\",\"description\":\"\",\"entries\":{\"count\":12,\"items\":[{\"sectionId\":\"s9289544\",\"name\":\"Soups\",\"entries\":{\"count\":5,\"items\":[{\"entryId\":\"62463372\",\"name\":\"Westlake Rice Porridge\",\"description\":\"Rare beef, crunchy scallop floss, soft egg.\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463373\",\"name\":\"Pea Leaves in Squash Broth\",\"description\":\"Aged chili, boiled peanuts, pumpkin seed oil.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463374\",\"name\":\"Tamarind Catfish Claypot\",\"description\":\"Mussels, grilled chicken wing, fragrant herbs.\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463375\",\"name\":\"Aromatic Lamb Broth\",\"description\":\"Goji berries, papaya, cellophane noodles.\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463376\",\"name\":\"Winter Melon\",\"description\":\"Ham and black truffle broth, served en croute.\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289545\",\"name\":\"Raw Bar\",\"entries\":{\"count\":7,\"items\":[{\"entryId\":\"62463377\",\"name\":\"Half Dozen Oysters Or Clams On the Half Shell\",\"description\":\"Calamansi vinegar, seaweed mignonette.\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463378\",\"name\":\"Half Dozen Jonah Crab Claws\",\"description\":\"Whipped seaweed and spirulina butter.\",\"prices\":[\"33.00\"],\"price\":\"33.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463379\",\"name\":\"Our Favorite Anchovies\",\"description\":\"Pickled chili condiment, tartine flatbread.\",\"prices\":[\"12.50\"],\"price\":\"12.50\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463380\",\"name\":\"Tray of Sea Urchin\",\"description\":\"Served with squid ink naan.\",\"prices\":[\"50.00\"],\"price\":\"50.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463381\",\"name\":\"Market Sashimi\",\"description\":\"Miso cured roe, apple, wasabi.\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463382\",\"name\":\"Today's Sashimi\",\"prices\":[\"16.00\"],\"price\":\"16.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463383\",\"name\":\"50 Grams of Caviar\",\"description\":\"Royal transmontanus, delta white sturgeon, ca salted kefir butter, tartine flatbread.\",\"prices\":[\"110.00\"],\"price\":\"110.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289546\",\"name\":\"Cold Appetizers\",\"entries\":{\"count\":7,\"items\":[{\"entryId\":\"62463384\",\"name\":\"Sichuan Pickled Vegetable Platter\",\"description\":\"Vinegar braised peanuts, tingly cucumbers.\",\"prices\":[\"10.00\"],\"price\":\"10.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463385\",\"name\":\"Red Cabbage Salad\",\"description\":\"Sesame, anchovy, crunchy buckwheat.\",\"prices\":[\"12.00\"],\"price\":\"12.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463386\",\"name\":\"Tiger Salad\",\"description\":\"Shaved daikon radish, herbs, spicy turnip vinaigrette.\",\"prices\":[\"12.00\"],\"price\":\"12.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463387\",\"name\":\"Chewy Pork and Beef Brisket\",\"description\":\"Ma la vinaigrette, served chilled.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463388\",\"name\":\"Green Papaya and Banana Blossom Salad\",\"description\":\"Shredded chicken, green tomatoes, pickled tea leaves, peanuts.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463389\",\"name\":\"Our Favorite Anchovies\",\"description\":\"Served in its tin, pickled chili condiment, tartine flatbread.\",\"prices\":[\"12.50\"],\"price\":\"12.50\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463390\",\"name\":\"\\\"Lettuce Cups\\\"\",\"description\":\"Beef tartare, miso cured salmon roe, fried onions.\",\"prices\":[\"19.00\"],\"price\":\"19.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289547\",\"name\":\"Hot Appetizers\",\"entries\":{\"count\":7,\"items\":[{\"entryId\":\"62463391\",\"name\":\"Chongoing Chicken Wings*\",\"description\":\"Crispy beef tripe, explosive chili, xinjiang spices.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463392\",\"name\":\"Char Siu Pork Riblets\",\"description\":\"Pickled beet and hibiscus glaze.\",\"prices\":[\"15.00\"],\"price\":\"15.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463393\",\"name\":\"Age Dashi Turnip Cake\",\"description\":\"Peanut milk, bonito flakes, grated daikon.\",\"prices\":[\"12.00\"],\"price\":\"12.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463394\",\"name\":\"Bread Service\",\"description\":\"Salted kefir butter.\",\"prices\":[\"7.00\"],\"price\":\"7.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463395\",\"name\":\"Caviar Service\",\"prices\":[\"30.00\"],\"price\":\"30.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463396\",\"name\":\"Hot Cheese Pizza\",\"description\":\"From the wood oven, d.o.p.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463397\",\"name\":\"Clams in Black Bean Sauce\",\"description\":\"Thickened with pig's blood.\",\"prices\":[\"18.00\"],\"price\":\"18.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289548\",\"name\":\"Chef's Specialties\",\"entries\":{\"count\":12,\"items\":[{\"entryId\":\"62463398\",\"name\":\"Broccoli Beef Brisket\",\"description\":\"Smoked oyster sauce, flax seeds.\",\"prices\":[\"18.00\"],\"price\":\"18.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463399\",\"name\":\"Thrice Cooked Bacon*\",\"description\":\"Shanghai rice cakes, bitter melon, sweet tofu skins.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463400\",\"name\":\"Kung Pao Pastrami*\",\"description\":\"Home fries, tianjin chili, celery and peanuts.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463401\",\"name\":\"Cumin Lamb Ribs\",\"description\":\"Charred onions, dates.\",\"prices\":[\"21.00\"],\"price\":\"21.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463402\",\"name\":\"Mapo Tofu*\",\"description\":\"Heritage pork, aged beef fat, doubanjiang.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463403\",\"name\":\"Stir Fried Pork Belly and Radishes\",\"description\":\"Black beans, chicharrones, shiso, mint.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463404\",\"name\":\"Koji Fried Chicken\",\"description\":\"Hainanese style, bitter lemon.\",\"prices\":[\"23.00\"],\"price\":\"23.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463405\",\"name\":\"Whole Smoked Pork Jowl\",\"description\":\"Hong kong style. served with three sauces and cloud bread.\",\"prices\":[\"35.00\"],\"price\":\"35.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463406\",\"name\":\"Shellfish in Black Bean Sauce\",\"description\":\"Thickened with pig's blood.\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463407\",\"name\":\"Big Tray Fish\",\"description\":\"Purple taro, turnip, wheat noodles.\",\"prices\":[\"50.00\"],\"price\":\"50.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463408\",\"name\":\"Wood Oven Fish\",\"description\":\"Drunken style. fresh turmeric, charred chili pickles, sichuan pepper oil.\",\"prices\":[\"37.00\"],\"price\":\"37.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463409\",\"name\":\"Salt and Pepper Lamb Rib Tip\",\"description\":\"Kefir creme fraiche, wild sumac, sweet pickles, hot bread.\",\"prices\":[\"37.00\"],\"price\":\"37.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289549\",\"name\":\"Rice Corner\",\"entries\":{\"count\":4,\"items\":[{\"entryId\":\"62463410\",\"name\":\"Salt Cod Fried Rice\",\"description\":\"Chinese sausage, lettuce, egg.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463411\",\"name\":\"Beef Jerky Fried Rice\",\"description\":\"Beech mushrooms, onions, sawtooth herb.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463412\",\"name\":\"Lobster and Coconut Fried Rice\",\"prices\":[\"29.00\"],\"price\":\"29.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463413\",\"name\":\"Side of Steamed Rice\",\"description\":\"Jasmine or brown barley.\",\"prices\":[\"3.00\"],\"price\":\"3.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289550\",\"name\":\"Noodle Corner\",\"entries\":{\"count\":5,\"items\":[{\"entryId\":\"62463414\",\"name\":\"Chewy Green Tea Noodles\",\"description\":\"Ginger scallion, hoisin, matcha.\",\"prices\":[\"9.00\"],\"price\":\"9.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463415\",\"name\":\"Squid Ink Peanut Noodles\",\"description\":\"Cucumber, fried peanuts, numbing oil.\",\"prices\":[\"12.50\"],\"price\":\"12.50\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463416\",\"name\":\"Stir Fried Wheat Noodles\",\"description\":\"Cumin, celery, catfish dressing.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463417\",\"name\":\"Steamed Oat Noodles\",\"description\":\"Charred eggplant, savory granola.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463418\",\"name\":\"Spicy Peanut Noodles\",\"description\":\"Cucumber, fried peanuts, numbing oil.\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289551\",\"name\":\"Vegetables\",\"description\":\"By The Pound.\",\"entries\":{\"count\":4,\"items\":[{\"entryId\":\"62463419\",\"name\":\"Mongolian Long Beans\",\"prices\":[\"13.00\"],\"price\":\"13.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463420\",\"name\":\"Black Kale and Pea Greens\",\"description\":\"Bone marrow broth, healthy grains.\",\"prices\":[\"14.00\"],\"price\":\"14.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463421\",\"name\":\"Stir Fried Celery\",\"description\":\"Sprouted hazelnuts, fresh lily bulb.\",\"prices\":[\"12.00\"],\"price\":\"12.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463422\",\"name\":\"Napa Cabbage\",\"description\":\"Steamed in chamomile and masala milk, flowers.\",\"prices\":[\"15.00\"],\"price\":\"15.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289552\",\"name\":\"Family Dishes\",\"description\":\"Limited Availability.\",\"entries\":{\"count\":3,\"items\":[{\"entryId\":\"62463423\",\"name\":\"Josefina's House Special Chicken\",\"prices\":[\"75.00\"],\"price\":\"75.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463424\",\"name\":\"Beggar's Duck\",\"description\":\"Roasted in lotus leaf and clay, cracked at the table.\",\"prices\":[\"100.00\"],\"price\":\"100.00\",\"options\":[],\"additions\":[]},{\"entryId\":\"62463425\",\"name\":\"Hickory Smoked Prime Rib\",\"description\":\"Garnished with a king crab leg.\",\"prices\":[\"150.00\"],\"price\":\"150.00\",\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289553\",\"name\":\"A Taste of Mission Chinese Food\",\"description\":\"Chefs Choice. Dinner: 5:30pm-12am.\",\"entries\":{\"count\":0,\"items\":[]}},{\"sectionId\":\"s9289554\",\"name\":\"Dinner - a\",\"description\":\"$69 Per Person\",\"entries\":{\"count\":11,\"items\":[{\"entryId\":\"62463426\",\"name\":\"Cold Appetizer\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463427\",\"name\":\"Hot Appetizer\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463428\",\"name\":\"Salad\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463429\",\"name\":\"Noodles\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463430\",\"name\":\"Mapo\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463431\",\"name\":\"Rice\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463432\",\"name\":\"Hong Kong Pork Jowl Or Koji Fried Chicken.\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463433\",\"name\":\"Bacon\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463434\",\"name\":\"Veg\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463435\",\"name\":\"Soup\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463436\",\"name\":\"Dessert\",\"prices\":[],\"options\":[],\"additions\":[]}]}},{\"sectionId\":\"s9289555\",\"name\":\"Dinner - B\",\"description\":\"$99 Per Person\",\"entries\":{\"count\":14,\"items\":[{\"entryId\":\"62463437\",\"name\":\"Raw Bar\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463438\",\"name\":\"Cold Appetizer\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463439\",\"name\":\"Hot Appetizer\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463440\",\"name\":\"Salad\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463441\",\"name\":\"Noodles\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463442\",\"name\":\"Mapo\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463443\",\"name\":\"Rice\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463444\",\"name\":\"Prime Rib Or House Special Chicken\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463445\",\"name\":\"Bacon\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463446\",\"name\":\"Veg Plus\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463447\",\"name\":\"Soup\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463448\",\"name\":\"Dessert\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463449\",\"name\":\"Dessert Plus\",\"prices\":[],\"options\":[],\"additions\":[]},{\"entryId\":\"62463450\",\"name\":\"Cocktail Cart\",\"prices\":[],\"options\":[],\"additions\":[]}]}}]}}]}}}}",
+	"menu_options_synthetic":      "{\"meta\":{\"code\":200,\"requestId\":\"5b0d6c3a4434b9002c6b7a90\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":0}}],\"response\":{\"menu\":{\"provider\":{\"name\":\"singleplatform\",\"attributionImage\":\"https://as.singleplatform.com/Foursquare/joes-pizza/provided_by.png\",\"attributionLink\":\"http://places.singleplatform.com/joes-pizza/menu?ref=Foursquare\",\"attributionText\":\"Disclaimer: Always check with the business for pricing and availability of menu items.\"},\"menus\":{\"count\":1,\"items\":[{\"menuId\":\"m2vqq6k1hm3x2rgz5e0ayodg8\",\"name\":\"Menu\",\"description\":\"\",\"entries\":{\"count\":1,\"items\":[{\"sectionId\":\"s1124431\",\"name\":\"Pizza\",\"entries\":{\"count\":1,\"items\":[{\"entryId\":\"48011822\",\"name\":\"Cheese Pizza\",\"description\":\"Plain cheese slice or pie.\",\"prices\":[\"3.00\",\"22.00\"],\"price\":\"3.00\",\"options\":[{\"optionGroupId\":\"og48011822\",\"name\":\"Size\",\"description\":\"\",\"items\":[{\"optionId\":\"o1\",\"name\":\"Slice\",\"price\":\"3.00\",\"prices\":[\"3.00\"]},{\"optionId\":\"o2\",\"name\":\"Whole Pie\",\"price\":\"22.00\",\"prices\":[\"22.00\"]}]}],\"additions\":[{\"optionGroupId\":\"ag48011822\",\"name\":\"Toppings\",\"description\":\"Per slice\",\"items\":[{\"optionId\":\"a1\",\"name\":\"Pepperoni\",\"price\":\"1.00\",\"prices\":[\"1.00\"]},{\"optionId\":\"a2\",\"name\":\"Mushrooms\",\"price\":\"0.75\",\"prices\":[\"0.75\"]}]}]}]}}]}}]}}}}",
+	"nextvenues":                  "{\"meta\":{\"code\":200,\"requestId\":\"58586ade1ed2190428023925\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"nextVenues\":{\"count\":5,\"items\":[{\"id\":\"4acbe67af964a52044c820e3\",\"name\":\"Katz's Delicatessen\",\"contact\":{\"phone\":\"8004468364\",\"formattedPhone\":\"(800) 446-8364\",\"twitter\":\"katzsdeli\",\"facebook\":\"89033874836\",\"facebookUsername\":\"katzsdeli\",\"facebookName\":\"Katz's Delicatessen\"},\"location\":{\"address\":\"205 E Houston St\",\"crossStreet\":\"at Ludlow St\",\"lat\":40.72230706635953,\"lng\":-73.98735165596008,\"postalCode\":\"10002\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"205 E Houston St (at Ludlow St)\",\"New York, NY 10002\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d1c5941735\",\"name\":\"Sandwich Place\",\"pluralName\":\"Sandwich Places\",\"shortName\":\"Sandwiches\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/deli_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":62638,\"usersCount\":47313,\"tipCount\":1120},\"url\":\"http:\\/\\/katzsdelicatessen.com\",\"hasMenu\":true,\"delivery\":{\"id\":\"25417\",\"url\":\"http:\\/\\/www.seamless.com\\/food-delivery\\/restaurant.25417.r?a=1026&utm_source=Foursquare&utm_medium=affiliate&utm_campaign=SeamlessOrderDeliveryLink\",\"provider\":{\"name\":\"seamless\"}},\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/katzs-delicatessen\\/4acbe67af964a52044c820e3\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/4acbe67af964a52044c820e3\\/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"venuePage\":{\"id\":\"36639778\"}},{\"id\":\"4cd5f138aeb1224b77be25ff\",\"name\":\"Il Laboratorio Del Gelato\",\"contact\":{\"phone\":\"2123439922\",\"formattedPhone\":\"(212) 343-9922\",\"facebook\":\"112378065860\",\"facebookName\":\"il laboratorio del gelato\"},\"location\":{\"address\":\"188 Ludlow St\",\"crossStreet\":\"at E Houston St\",\"lat\":40.72250100787867,\"lng\":-73.98712646658963,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.72250100787867,\"lng\":-73.98712646658963}],\"postalCode\":\"10002\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"188 Ludlow St (at E Houston St)\",\"New York, NY 10002\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d1c9941735\",\"name\":\"Ice Cream Shop\",\"pluralName\":\"Ice Cream Shops\",\"shortName\":\"Ice Cream\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/icecream_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":15628,\"usersCount\":10365,\"tipCount\":313},\"url\":\"http:\\/\\/www.laboratoriodelgelato.com\",\"hasMenu\":true,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/il-laboratorio-del-gelato\\/4cd5f138aeb1224b77be25ff\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/4cd5f138aeb1224b77be25ff\\/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"venuePage\":{\"id\":\"99503910\"}},{\"id\":\"460d4b1ef964a52004451fe3\",\"name\":\"Whole Foods Market\",\"contact\":{\"phone\":\"2124201320\",\"formattedPhone\":\"(212) 420-1320\",\"twitter\":\"wholefoodsnyc\",\"facebook\":\"160380060670252\",\"facebookName\":\"Wholefoods\"},\"location\":{\"address\":\"95 East Houston St\",\"crossStreet\":\"btwn Bowery & Chrystie St\",\"lat\":40.72378919148991,\"lng\":-73.99158563544898,\"postalCode\":\"10002\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"95 East Houston St (btwn Bowery & Chrystie St)\",\"New York, NY 10002\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d118951735\",\"name\":\"Grocery Store\",\"pluralName\":\"Grocery Stores\",\"shortName\":\"Grocery Store\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/shops\\/food_grocery_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":85850,\"usersCount\":23078,\"tipCount\":314},\"url\":\"http:\\/\\/www.wholefoodsmarket.com\\/stores\\/bowery\",\"hasMenu\":true,\"menu\":{\"type\":\"Products\",\"label\":\"Products\",\"anchor\":\"View Products\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/whole-foods-market\\/460d4b1ef964a52004451fe3\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/460d4b1ef964a52004451fe3\\/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"storeId\":\"HOU\",\"locked\":true},{\"id\":\"4657db7ef964a5200f471fe3\",\"name\":\"Cocoa Bar\",\"contact\":{\"phone\":\"2126777417\",\"formattedPhone\":\"(212) 677-7417\",\"twitter\":\"cocoabarnyc\",\"facebook\":\"58656595944\",\"facebookUsername\":\"cocoabarnyc\",\"facebookName\":\"Cocoa Bar\"},\"location\":{\"address\":\"21 Clinton St\",\"crossStreet\":\"btwn  Avenue B & Houston St\",\"lat\":40.72059136642329,\"lng\":-73.98430466651917,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.72059136642329,\"lng\":-73.98430466651917}],\"postalCode\":\"10002\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"21 Clinton St (btwn  Avenue B & Houston St)\",\"New York, NY 10002\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d16d941735\",\"name\":\"Café\",\"pluralName\":\"Cafés\",\"shortName\":\"Café\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/cafe_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":5170,\"usersCount\":2590,\"tipCount\":67},\"url\":\"http:\\/\\/www.cocoabarnyc.com\",\"hasMenu\":true,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/cocoa-bar\\/4657db7ef964a5200f471fe3\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/4657db7ef964a5200f471fe3\\/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"venuePage\":{\"id\":\"81252401\"},\"storeId\":\"\"},{\"id\":\"4d406a44de17a0932403f86b\",\"name\":\"Pause Cafe\",\"contact\":{\"phone\":\"2126775415\",\"formattedPhone\":\"(212) 677-5415\",\"twitter\":\"pausecafenyc\"},\"location\":{\"address\":\"3 Clinton St\",\"crossStreet\":\"btwn E Houston & Stanton St\",\"lat\":40.7212077,\"lng\":-73.9840915,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.7212077,\"lng\":-73.9840915}],\"postalCode\":\"10002\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"3 Clinton St (btwn E Houston & Stanton St)\",\"New York, NY 10002\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d112941735\",\"name\":\"Juice Bar\",\"pluralName\":\"Juice Bars\",\"shortName\":\"Juice Bar\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/food\\/juicebar_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"checkinsCount\":4919,\"usersCount\":1806,\"tipCount\":90},\"url\":\"http:\\/\\/pausecafenyc.com\",\"hasMenu\":true,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https:\\/\\/foursquare.com\\/v\\/pause-cafe\\/4d406a44de17a0932403f86b\\/menu\",\"mobileUrl\":\"https:\\/\\/foursquare.com\\/v\\/4d406a44de17a0932403f86b\\/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"venuePage\":{\"id\":\"95461219\"}}]}}}",
+	"photos":                      "{\"meta\":{\"code\":200,\"requestId\":\"5aff409bdb04f52754d1ea24\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":5}}],\"response\":{\"photos\":{\"count\":30,\"items\":[{\"id\":\"549ecb0f11d2ed4887ba35ab\",\"createdAt\":1419692815,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_78vNYkB4sZbQ23LykVYIccyi2zSkD98qo3CHkQ-vI5k.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb4111d2ed4887ba3e39\",\"createdAt\":1419692865,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_UyZ6PVFIBRiI1BEn2pZazaTHIe2Amd4wnapdyxLcc30.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb8811d2ed4887ba49e0\",\"createdAt\":1419692936,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_wtJSS6Ie9foJROFQyQX7VFWXL2z0sEBbM7shBgXe_7A.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb6c11d2ed4887ba437d\",\"createdAt\":1419692908,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_9mZGW7A2zBARNjlnxY80c3190ZDXVC-wLL7AoxiQQVs.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"549ecb8011d2ed4887ba4653\",\"createdAt\":1419692928,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_EkILTGGTQU_hADvbaZ3aZGt3phdM5-I_LFEpxA2y09M.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"},{\"id\":\"557c5e2f498ef101ad762208\",\"createdAt\":1434213935,\"source\":{\"name\":\"4sq for iOS (internal)\",\"url\":\"http://developer.foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/31666_msN7RnxD2cfdVgxUwtrTw4hwk6ZYFQQVsZOFDWflQ-c.jpg\",\"width\":1439,\"height\":1920,\"demoted\":false,\"user\":{\"id\":\"31666\",\"firstName\":\"Tim\",\"lastName\":\"Julien\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NNN0TJJR4IQM2MFA.jpg\"}},\"tip\":{\"id\":\"557c5e1b498ee9d1985ca74d\",\"createdAt\":1434213915,\"text\":\"Epic, airy vibe both inside and out. Huge bar area, outdoor patio, and sneaky ninth street espresso bk outpost tucked inside\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/557c5e1b498ee9d1985ca74d\",\"flags\":[],\"likes\":{\"count\":2,\"groups\":[{\"type\":\"others\",\"count\":2,\"items\":[{\"id\":\"29689102\",\"firstName\":\"Kyle\",\"lastName\":\"Bye\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NGLQ5LUNL3DUJQXK.jpg\"}},{\"id\":\"242662\",\"firstName\":\"Vineet\",\"lastName\":\"Shah\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/QKJ4W3CB3GHLUACL.jpg\"}}]}],\"summary\":\"2 likes\"},\"like\":false,\"logView\":true,\"agreeCount\":4,\"disagreeCount\":0,\"todo\":{\"count\":2}},\"visibility\":\"public\"},{\"id\":\"552482df498e0dc94c830009\",\"createdAt\":1428456159,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/16375_ClgxFLPSfo5V2b8cG4w30Fg3b8zMOM5IxSTTTr0_VoI.jpg\",\"width\":1081,\"height\":1920,\"demoted\":false,\"user\":{\"id\":\"16375\",\"firstName\":\"Joshua\",\"lastName\":\"Stylman\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/4a3b996472147.jpg\"}},\"visibility\":\"public\"},{\"id\":\"548a3099498e61a3e84358f0\",\"createdAt\":1418342553,\"source\":{\"name\":\"Instagram\",\"url\":\"http://instagram.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/1219_szWOsHQEbZH53ic3aO7GYhEXBh_ziILLprEU9Ofe_7Q.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"1219\",\"firstName\":\"Peter\",\"lastName\":\"Knox\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/PXQ54ZLND0DJFGIO.jpg\"}},\"checkin\":{\"id\":\"548a3098498e8897cdae7a91\",\"createdAt\":1418342552,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"54e4daa7498edf821ae86cfb\",\"createdAt\":1424284327,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https://foursquare.com/download/#/iphone\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/74301_Qs1UNVgpKNrbhuXltakKnWWEU4NhdPd5qWr4EqG7xoQ.jpg\",\"width\":1440,\"height\":1920,\"demoted\":false,\"user\":{\"id\":\"74301\",\"firstName\":\"rhea\",\"lastName\":\"sunshine\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/74301_su2VCAft_YzgK1J-B5j1nPR4GZ6aUrGzy6Sty5IDvq6CdvhmXokYxdLCMRSOOPxtHpOewTfGt.jpg\"}},\"tip\":{\"id\":\"54e4da9c498e6f07ae65402a\",\"createdAt\":1424284316,\"text\":\"Good beer selection. Their saison is probably my new favorite saison.\",\"type\":\"user\",\"canonicalUrl\":\"https://foursquare.com/item/54e4da9c498e6f07ae65402a\",\"flags\":[],\"likes\":{\"count\":0,\"groups\":[]},\"like\":false,\"logView\":true,\"agreeCount\":0,\"disagreeCount\":0,\"todo\":{\"count\":0}},\"visibility\":\"public\"},{\"id\":\"54b16e3e498ebcc3d84e9e93\",\"createdAt\":1420914238,\"source\":{\"name\":\"Instagram\",\"url\":\"http://instagram.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/1987602_1wXzGIMio44n4RqbRDQXxFe583-ZzNksWpPCMFM7vC0.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"1987602\",\"firstName\":\"Thiago\",\"lastName\":\"Lins\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1987602-KMUDHLTUAIMHMNW4.jpg\"}},\"checkin\":{\"id\":\"54b1633a498ec264f7b6c384\",\"createdAt\":1420911418,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"548dc913498eb3fa19fc62c7\",\"createdAt\":1418578195,\"source\":{\"name\":\"Untappd\",\"url\":\"https://untappd.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/2803097_6u0XYGACgoiT81osx8VEQjkB7B0ZR-O-gvXFZtxMIYY.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"2803097\",\"firstName\":\"Jeven\",\"lastName\":\"Dew\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2803097-Z5VFAWA3IKR52ANV.jpg\"}},\"checkin\":{\"id\":\"548dc911498e99576148db1c\",\"createdAt\":1418578193,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"550c3a47498e177fb9c2dd53\",\"createdAt\":1426864711,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/216312_QXjHuqu1i5VJa35sxmP-XvCmqcHfTKowEyR0CjYIeC8.jpg\",\"width\":1920,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"216312\",\"firstName\":\"Joshua\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/LBDFEEDCTE44DSMG.jpg\"}},\"checkin\":{\"id\":\"550c3a42498ec5febf39b1b7\",\"createdAt\":1426864706,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"549491ba498ea184c15c2f9c\",\"createdAt\":1419022778,\"source\":{\"name\":\"Untappd\",\"url\":\"https://untappd.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/821484_uIxxCbXEWKxXsrnibJ8OMxjPYplny8aKqgjGC91LvqE.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"821484\",\"firstName\":\"Albert\",\"lastName\":\"Oeswadi\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/821484-KNDOFBB1FK2HOWFN.jpg\"}},\"checkin\":{\"id\":\"549491b8498e19080b72a656\",\"createdAt\":1419022776,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"55135f4a498e980558712fda\",\"createdAt\":1427332938,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/1212_TrKpSBQr3uSNpd6y2yEFSaJ9svyJzvii9Cw0ROSKI1A.jpg\",\"width\":1440,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"1212\",\"firstName\":\"Conrad\",\"lastName\":\"Doucette\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1212-FYXTRHAJEC0BXQ0H.jpg\"}},\"checkin\":{\"id\":\"55135ac2498e938c15e4f43b\",\"createdAt\":1427331778,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55971e44498e358d3140d31c\",\"createdAt\":1435967044,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/57510_lOdsEZu5pdr4dglpVsLFAkPhW7SS42_lZvcj8mwLERQ.jpg\",\"width\":960,\"height\":720,\"demoted\":false,\"user\":{\"id\":\"57510\",\"firstName\":\"ron\",\"lastName\":\"j\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/57510_jevb6RWC_lwiaEh435uciAGpJ2KIeiV0vAN90HCElHmSM_EJBv0PHHZNLaYpAlfE-9G22S5dd.jpg\"}},\"checkin\":{\"id\":\"55971cb3498e379b38c57219\",\"createdAt\":1435966643,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"57c1f31acd10e8433b02a2bf\",\"createdAt\":1472328474,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/697943_9U0RAhvp9E8MWuu_NNMw9L3q1jDKBlrqan9hlMrUj2M.jpg\",\"width\":1920,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"697943\",\"firstName\":\"Noah\",\"lastName\":\"Weiss\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/697943_jQx3rpaR_DmCtX0lbxNf3D3idRsUdoFl_feXc4MkLlYeHO5BkTZg0NY0SXHF9tYgq50UFxsnx.jpg\"}},\"checkin\":{\"id\":\"57c1f313498e21a9727cd3cc\",\"createdAt\":1472328467,\"type\":\"checkin\",\"timeZoneOffset\":-240,\"with\":[{\"id\":\"11991983\",\"firstName\":\"Monica\",\"lastName\":\"Desai\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/IXJRPDVXHJ0FOJIH.jpg\"}}]},\"visibility\":\"public\"},{\"id\":\"543ae213498ef53b3f3dac32\",\"createdAt\":1413145107,\"source\":{\"name\":\"Instagram\",\"url\":\"http://instagram.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/3031033_HX4l3-r6KTUEyED4x12SOglyKqFjxVzsP_cEbgDutac.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"3031033\",\"firstName\":\"LT\",\"lastName\":\"10002\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/EUML0GCUUBEMDAU0.jpg\"}},\"checkin\":{\"id\":\"543ae212498e3c55f1367310\",\"createdAt\":1413145106,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55a5acf3498ebb93742f8471\",\"createdAt\":1436921075,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/5600267__O8Q4W4oLYtNzRCzdB6RA2azbOqjc9GOZlrQbiIJZBg.jpg\",\"width\":1440,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"5600267\",\"firstName\":\"emma\",\"lastName\":\"tangoren\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/5600267-EMIEFCAFBSVDKSYV.jpg\"}},\"checkin\":{\"id\":\"55a5aced498e2ac5e2ad8d8b\",\"createdAt\":1436921069,\"type\":\"checkin\",\"timeZoneOffset\":-240,\"with\":[{\"id\":\"2178630\",\"firstName\":\"Michal\",\"lastName\":\"Rosenn\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2178630-UF22LC4HZB5QAEGT\"}},{\"id\":\"2814182\",\"firstName\":\"Bridget\",\"lastName\":\"Best\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/2814182-ATKZGZPVTYKGR0UD.jpg\"}},{\"id\":\"1179\",\"firstName\":\"David\",\"lastName\":\"Gallagher\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/1179_1238502187.jpg\"}}]},\"visibility\":\"public\"},{\"id\":\"55c29ad5498e39bd99704129\",\"createdAt\":1438816981,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/12600931_M5OSQ7mM5NxgH_Um11ooeFnGxfhxaJwCu_KTlcZx-h0.jpg\",\"width\":1920,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"12600931\",\"firstName\":\"Ben\",\"lastName\":\"Shirai\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/0BWSYDI24TIZTXL4.jpg\"}},\"checkin\":{\"id\":\"55c29ad2498ef7e806650e30\",\"createdAt\":1438816978,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"54b9d8b8498e3d1ccc6eb626\",\"createdAt\":1421465784,\"source\":{\"name\":\"Instagram\",\"url\":\"http://instagram.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/8707_Gudrdwj5JtHkYZGExt7fczNikECmA_46IlGrRtNij84.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"8707\",\"firstName\":\"Angela\",\"lastName\":\"Im\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/8707-L4IXHOCMCTPVI1YS.jpg\"}},\"checkin\":{\"id\":\"54b9d8b7498eba8cb7499ee0\",\"createdAt\":1421465783,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"59090d164a7aae76e1631255\",\"createdAt\":1493765398,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/5751888_gr5CulNkJCU7dIV2EB8CMXMpj6QxRptSJPK4H3YPG0k.jpg\",\"width\":1920,\"height\":1440,\"demoted\":false,\"user\":{\"id\":\"5751888\",\"firstName\":\"Derek T.\",\"lastName\":\"Ku\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/5751888_9mBGLc8u_ILlxlotwEO0NTNLi4JardqZs8NLp8v5Gpw6Px3QM995euLiOZfkSkeFNjMhHtg3m.jpg\"}},\"checkin\":{\"id\":\"59090d14c824ae3d3415e97d\",\"createdAt\":1493765396,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"54c3dd82498ea7ea35edaca9\",\"createdAt\":1422122370,\"source\":{\"name\":\"Untappd\",\"url\":\"https://untappd.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/63943_xthrZGHRHCe8nR54reIcgnaWcBjQnvsjzBzKhp9rtpA.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"63943\",\"firstName\":\"Adam\",\"lastName\":\"Chlan\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/63943-03UZOAVFZ0353U5C.jpg\"}},\"checkin\":{\"id\":\"54c3dd81498e0c606368422e\",\"createdAt\":1422122369,\"type\":\"checkin\",\"timeZoneOffset\":-300},\"visibility\":\"public\"},{\"id\":\"555bd32e498e737f29f81b55\",\"createdAt\":1432081198,\"source\":{\"name\":\"Swarm for Android\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/93676_mzNOzQ_K1x3rnyaM0cIaa-mLUTGgPOQRROuIHdYgOWA.jpg\",\"width\":960,\"height\":720,\"demoted\":false,\"user\":{\"id\":\"93676\",\"firstName\":\"John\",\"lastName\":\"Jay\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/93676-EXVLMT0RXKQNXCRH.jpg\"}},\"checkin\":{\"id\":\"555bd32b498ee08ba608cb27\",\"createdAt\":1432081195,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55d124ca498e45c5a92e93c2\",\"createdAt\":1439769802,\"source\":{\"name\":\"Untappd\",\"url\":\"https://untappd.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/1135219_V64BvVKadp_Ls7UANG_MEHg7YprE1tCKvKnXBKmMhyo.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"1135219\",\"firstName\":\"Carrie\",\"lastName\":\"Soom\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/E4TMM4TCO0SLUX4C.jpg\"}},\"checkin\":{\"id\":\"55d1245a498e96ce16c3f6eb\",\"createdAt\":1439769690,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"5556743b498e253a908976d4\",\"createdAt\":1431729211,\"source\":{\"name\":\"Swarm for Android\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/32311084_qa7tCfjdJCiac22uVzDNjlefu1g1uVB-4DzKFM5EhLg.jpg\",\"width\":960,\"height\":543,\"demoted\":false,\"user\":{\"id\":\"32311084\",\"firstName\":\"Mel\",\"lastName\":\"Stanger\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/32311084-KVY5JO12HH32P04L.jpg\"}},\"checkin\":{\"id\":\"55567386498e964cdce5941f\",\"createdAt\":1431729030,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"5557ab77498ed4a78dccada0\",\"createdAt\":1431808887,\"source\":{\"name\":\"Untappd\",\"url\":\"https://untappd.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/2302931_pabXOFkgOrps9DPRRx9svS63nreSHIOSmyzvhci_ERM.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"2302931\",\"firstName\":\"Steve\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/S3AEJZAAZVEN2KMN.jpg\"}},\"checkin\":{\"id\":\"5557ab74498ef3bcd8f7d395\",\"createdAt\":1431808884,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55a98911498e2360e833608c\",\"createdAt\":1437174033,\"source\":{\"name\":\"Swarm for iOS\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/31666_JQBhkxwYekQjGdNCm4i9Nqmgj6UDPoy3WBsh-UiaAEM.jpg\",\"width\":1440,\"height\":1920,\"demoted\":false,\"user\":{\"id\":\"31666\",\"firstName\":\"Tim\",\"lastName\":\"Julien\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/NNN0TJJR4IQM2MFA.jpg\"}},\"checkin\":{\"id\":\"55a988e4498e4ccadd0d0c26\",\"createdAt\":1437173988,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55805fea498ecccc65921d8b\",\"createdAt\":1434476522,\"source\":{\"name\":\"Instagram\",\"url\":\"http://instagram.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/871399_EVXXJb-wz0DpYnPzBq1Vyj-0WuqIip6lUuOH7Du_foQ.jpg\",\"width\":640,\"height\":640,\"demoted\":false,\"user\":{\"id\":\"871399\",\"firstName\":\"Marinna\",\"lastName\":\"Pavone\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/TSKSDNVZYIXYF10B.jpg\"}},\"checkin\":{\"id\":\"55805fe7498e89e7b4bbf9a5\",\"createdAt\":1434476519,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"55a16cae498eeed959e90760\",\"createdAt\":1436642478,\"source\":{\"name\":\"Swarm for Android\",\"url\":\"https://www.swarmapp.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/5250189_uz4azC89EdMKyMjtod5vsr-2fGS2ACQDtbRdQrXYt_o.jpg\",\"width\":960,\"height\":720,\"demoted\":false,\"user\":{\"id\":\"5250189\",\"firstName\":\"Eddie\",\"lastName\":\"Pak\",\"gender\":\"male\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/5250189-1ARS0QE4O5S0DHV4.jpg\"}},\"checkin\":{\"id\":\"55a16ca8498e8e06e13359f6\",\"createdAt\":1436642472,\"type\":\"checkin\",\"timeZoneOffset\":-240},\"visibility\":\"public\"},{\"id\":\"549ecb7511d2ed4887ba4460\",\"createdAt\":1419692917,\"source\":{\"name\":\"Foursquare Web\",\"url\":\"https://foursquare.com\"},\"prefix\":\"https://igx.4sqi.net/img/general/\",\"suffix\":\"/95760005_ylNY0UFYKsXeWsniu3UrgXSyUxAsQ9Yc_81tg-4sYsY.jpg\",\"width\":870,\"height\":580,\"demoted\":false,\"user\":{\"id\":\"95760005\",\"firstName\":\"Threes Brewing\",\"gender\":\"none\",\"photo\":{\"prefix\":\"https://igx.4sqi.net/img/user/\",\"suffix\":\"/95760005-K35NSGGG10EE5XU2.png\"},\"type\":\"venuePage\",\"venue\":{\"id\":\"5414d0a6498ea3d31a3c64cf\"}},\"visibility\":\"public\"}],\"dupesRemoved\":0}}}",
+	"search":                      "{\"meta\":{\"code\":200,\"requestId\":\"5aff42781ed2192af996304e\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":5}}],\"response\":{\"venues\":[{\"id\":\"4f68de6bd5fbee32e5f4f3a5\",\"name\":\"SingleCut Beersmiths\",\"contact\":{\"phone\":\"7186060788\",\"formattedPhone\":\"(718) 606-0788\",\"twitter\":\"singlecutbeer\"},\"location\":{\"address\":\"19-33 37th St\",\"crossStreet\":\"btwn 19th & 20th Ave\",\"lat\":40.778386547058325,\"lng\":-73.9019024216154,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.778386547058325,\"lng\":-73.9019024216154}],\"distance\":12025,\"postalCode\":\"11105\",\"cc\":\"US\",\"city\":\"Astoria\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"19-33 37th St (btwn 19th & 20th Ave)\",\"Astoria, NY 11105\"]},\"categories\":[{\"id\":\"50327c8591d4c4b30a586d5d\",\"name\":\"Brewery\",\"pluralName\":\"Breweries\",\"shortName\":\"Brewery\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/food/brewery_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":true,\"stats\":{\"tipCount\":88,\"usersCount\":3640,\"checkinsCount\":7270},\"url\":\"http://www.singlecutbeer.com\",\"hasMenu\":true,\"menu\":{\"type\":\"Menu\",\"label\":\"Menu\",\"anchor\":\"View Menu\",\"url\":\"https://foursquare.com/v/singlecut-beersmiths/4f68de6bd5fbee32e5f4f3a5/menu\",\"mobileUrl\":\"https://foursquare.com/v/4f68de6bd5fbee32e5f4f3a5/device_menu\"},\"allowMenuUrlEdit\":true,\"beenHere\":{\"count\":1,\"marked\":true,\"lastCheckinExpiredAt\":0},\"specials\":{\"count\":0,\"items\":[]},\"venuePage\":{\"id\":\"42728420\"},\"storeId\":\"\",\"hereNow\":{\"count\":0,\"summary\":\"Nobody here\",\"groups\":[]},\"referralId\":\"v-1526678136\",\"venueChains\":[],\"hasPerk\":false}]}}",
+	"suggest":                     "{\"meta\":{\"code\":200,\"requestId\":\"5858656ddb04f53f912285aa\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"minivenues\":[{\"id\":\"5a187743ccad6b307315e6fe\",\"name\":\"Foursquare HQ\",\"location\":{\"address\":\"50 W 23rd St\",\"crossStreet\":\"btwn 5th & 6th Ave\",\"city\":\"New York\",\"state\":\"NY\",\"postalCode\":\"10010\",\"country\":\"US\",\"lat\":40.74199614860909,\"lng\":-73.9923735296422,\"distance\":4719},\"categories\":[{\"id\":\"4bf58dd8d48988d125941735\",\"name\":\"Tech Startup\",\"pluralName\":\"Tech Startups\",\"shortName\":\"Tech Startup\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/shops/technology_\",\"suffix\":\".png\"}},{\"id\":\"4d4b7105d754a06375d81259\",\"name\":\"Professional & Other Places\",\"pluralName\":\"Professional & Other Places\",\"shortName\":\"Professional\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/building/default_\",\"suffix\":\".png\"}},{\"id\":\"4bf58dd8d48988d124941735\",\"name\":\"Office\",\"pluralName\":\"Offices\",\"shortName\":\"Office\",\"icon\":{\"prefix\":\"https://ss3.4sqi.net/img/categories_v2/building/default_\",\"suffix\":\".png\"}}],\"hasPerk\":false}]}}",
+	"tips":                        "{\"meta\":{\"code\":200,\"requestId\":\"5859b8091ed219042620b391\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"tips\":{\"count\":20,\"items\":[{\"id\":\"57f1673c498e128bfb537f04\",\"createdAt\":1475438396,\"text\":\"Tasting room with games and seating for about 30. Awesome seasonal beers\",\"type\":\"user\",\"canonicalUrl\":\"https:\\/\\/foursquare.com\\/item\\/57f1673c498e128bfb537f04\",\"photo\":{\"id\":\"57f167aa498ea7494a04923e\",\"createdAt\":1475438506,\"source\":{\"name\":\"Foursquare for iOS\",\"url\":\"https:\\/\\/foursquare.com\\/download\\/#\\/iphone\"},\"prefix\":\"https:\\/\\/irs3.4sqi.net\\/img\\/general\\/\",\"suffix\":\"\\/4740390_BvMODplPMlY8q02V-bru2ym_MqpChXW2yYxAenuK1Dk.jpg\",\"width\":1440,\"height\":1799,\"demoted\":false,\"visibility\":\"public\"},\"photourl\":\"https:\\/\\/irs3.4sqi.net\\/img\\/general\\/original\\/4740390_BvMODplPMlY8q02V-bru2ym_MqpChXW2yYxAenuK1Dk.jpg\",\"flags\":[],\"likes\":{\"count\":0,\"groups\":[]},\"like\":false,\"logView\":true,\"agreeCount\":0,\"disagreeCount\":0,\"todo\":{\"count\":0},\"user\":{\"id\":\"4740390\",\"firstName\":\"Jennifer\",\"lastName\":\"T.\",\"gender\":\"female\",\"photo\":{\"prefix\":\"https:\\/\\/irs2.4sqi.net\\/img\\/user\\/\",\"suffix\":\"\\/0O1PZS5F34CLE3K2.jpg\"}},\"authorInteractionType\":\"liked\"}]}}}",
+	"trending":                    "{\"meta\":{\"code\":200,\"requestId\":\"585868911ed219042a174531\"},\"notifications\":[{\"type\":\"notificationTray\",\"item\":{\"unreadCount\":11}}],\"response\":{\"venues\":[{\"id\":\"4eb90d85722e09311d356915\",\"name\":\"World Trade Center Transportation Hub (The Oculus)\",\"contact\":{\"twitter\":\"pathtrain\"},\"location\":{\"address\":\"Liberty St & Church Ave\",\"lat\":40.711516296607606,\"lng\":-74.01123458506063,\"labeledLatLngs\":[{\"label\":\"display\",\"lat\":40.711516296607606,\"lng\":-74.01123458506063}],\"distance\":1594,\"postalCode\":\"10007\",\"cc\":\"US\",\"city\":\"New York\",\"state\":\"NY\",\"country\":\"United States\",\"formattedAddress\":[\"Liberty St & Church Ave\",\"New York, NY 10007\"]},\"categories\":[{\"id\":\"4bf58dd8d48988d1fd931735\",\"name\":\"Metro Station\",\"pluralName\":\"Metro Stations\",\"shortName\":\"Metro\",\"icon\":{\"prefix\":\"https:\\/\\/ss3.4sqi.net\\/img\\/categories_v2\\/travel\\/subway_\",\"suffix\":\".png\"},\"primary\":true}],\"verified\":false,\"stats\":{\"checkinsCount\":21287,\"usersCount\":8768,\"tipCount\":36},\"url\":\"http:\\/\\/www.panynj.gov\\/wtcprogress\\/transportation-hub.html\",\"venueRatingBlacklisted\":true,\"beenHere\":{\"lastCheckinExpiredAt\":0},\"hereNow\":{\"count\":7,\"summary\":\"7 people are here\",\"groups\":[{\"type\":\"others\",\"name\":\"Other people here\",\"count\":7,\"items\":[]}]}}]}}",
+}
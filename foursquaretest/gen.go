@@ -0,0 +1,53 @@
+//go:build ignore
+// +build ignore
+
+// gen writes fixtures.go from the json files in json/venues.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	files, err := filepath.Glob("../json/venues/*.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(files)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	b.WriteString("package foursquaretest\n\n")
+	b.WriteString("// fixtures are the files in json/venues keyed by name without .json.\n")
+	b.WriteString("var fixtures = map[string]string{\n")
+	for _, file := range files {
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			log.Fatalf("%s: %v", file, err)
+		}
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		fmt.Fprintf(&b, "\t%q: %s,\n", name, strconv.Quote(compact.String()))
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("fixtures.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
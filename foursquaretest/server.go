@@ -0,0 +1,413 @@
+// Package foursquaretest provides a fake Foursquare API server for testing
+// code that uses foursquarego without the network.
+//
+//	server := foursquaretest.NewServer()
+//	defer server.Close()
+//
+//	server.AddVenue(foursquarego.Venue{ID: "v1", Name: "Joe's Pizza"})
+//	client := server.Client()
+//	venue, _, err := client.Venues.Details("v1")
+//
+// Venue details and search are served from a venue store seeded with the
+// venues in the json/venues fixtures. Search filters the store by query,
+// sw/ne, ll with radius and categoryId. Every other venue endpoint returns
+// its canned fixture unless it is replaced with SetFixture.
+package foursquaretest
+
+//go:generate go run gen.go
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/geo"
+)
+
+// Credentials used by Server.Client.
+const (
+	ClientID     = "foursquaretest-client-id"
+	ClientSecret = "foursquaretest-client-secret"
+)
+
+// fixtureEndpoints are the canned fixture for every endpoint not served
+// from the venue store.
+var fixtureEndpoints = map[string]string{
+	foursquarego.EndpointCategories:        "categories",
+	foursquarego.EndpointExplore:           "explore",
+	foursquarego.EndpointTrending:          "trending",
+	foursquarego.EndpointSuggestCompletion: "suggest",
+	foursquarego.EndpointPhotos:            "photos",
+	foursquarego.EndpointEvents:            "events",
+	foursquarego.EndpointHours:             "hours",
+	foursquarego.EndpointLikes:             "likes",
+	foursquarego.EndpointLinks:             "links",
+	foursquarego.EndpointListed:            "listed",
+	foursquarego.EndpointNextVenues:        "nextvenues",
+	foursquarego.EndpointMenu:              "menu",
+	foursquarego.EndpointTips:              "tips",
+}
+
+// Request is a request the Server received.
+type Request struct {
+	Endpoint string
+	Method   string
+	Path     string
+	Query    url.Values
+	Header   http.Header
+}
+
+// Server is a fake Foursquare API.
+type Server struct {
+	server *httptest.Server
+
+	mu            sync.Mutex
+	venues        map[string]foursquarego.Venue
+	fixtures      map[string][]byte
+	errors        map[string]foursquarego.Meta
+	rateLimit     int
+	rateRemaining int
+	requests      []Request
+	requestCount  int
+}
+
+// NewServer starts a Server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		venues:   map[string]foursquarego.Venue{},
+		fixtures: map[string][]byte{},
+		errors:   map[string]foursquarego.Meta{},
+	}
+	s.seed()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL is the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// HTTPClient returns an http.Client that sends every request to the server
+// whatever host it was for.
+func (s *Server) HTTPClient() *http.Client {
+	target, _ := url.Parse(s.server.URL)
+	return &http.Client{
+		Transport: foursquarego.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+}
+
+// Client returns a foursquarego.Client talking to the server with
+// ClientID and ClientSecret.
+func (s *Server) Client() *foursquarego.Client {
+	return foursquarego.NewClient(s.HTTPClient(), "foursquare", ClientID, ClientSecret, "")
+}
+
+// AddVenue adds or replaces a venue in the store.
+func (s *Server) AddVenue(venues ...foursquarego.Venue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range venues {
+		s.venues[v.ID] = v
+	}
+}
+
+// ClearVenues empties the store including the seeded venues.
+func (s *Server) ClearVenues() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.venues = map[string]foursquarego.Venue{}
+}
+
+// SetFixture replaces the response for an endpoint such as venues.photos
+// with the whole json envelope in body.
+func (s *Server) SetFixture(endpoint string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[endpoint] = body
+}
+
+// InjectError makes every request to the endpoint fail with the meta,
+// an empty endpoint fails every request. Meta.Code is the status code.
+func (s *Server) InjectError(endpoint string, meta foursquarego.Meta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[endpoint] = meta
+}
+
+// ClearErrors removes all injected errors.
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = map[string]foursquarego.Meta{}
+}
+
+// SetRateLimit sends rate limit headers with every response. Remaining
+// goes down with every request and at 0 requests fail with
+// rate_limit_exceeded.
+func (s *Server) SetRateLimit(limit, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+	s.rateRemaining = remaining
+}
+
+// Requests returns the requests made to the endpoint, or all requests if
+// endpoint is empty.
+func (s *Server) Requests(endpoint string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []Request
+	for _, r := range s.requests {
+		if endpoint == "" || r.Endpoint == endpoint {
+			requests = append(requests, r)
+		}
+	}
+	return requests
+}
+
+// AssertRequested fails the test unless the last request to the endpoint
+// had every query parameter in want.
+func (s *Server) AssertRequested(t testing.TB, endpoint string, want map[string]string) {
+	t.Helper()
+
+	requests := s.Requests(endpoint)
+	if len(requests) == 0 {
+		t.Errorf("foursquaretest: no request to %s", endpoint)
+		return
+	}
+
+	query := requests[len(requests)-1].Query
+	for key, value := range want {
+		if got := query.Get(key); got != value {
+			t.Errorf("foursquaretest: %s %s = %q, want %q", endpoint, key, got, value)
+		}
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint, id := foursquarego.EndpointForPath(r.URL.Path)
+
+	s.mu.Lock()
+	s.requestCount++
+	requestID := fmt.Sprintf("%024x", s.requestCount)
+	s.requests = append(s.requests, Request{
+		Endpoint: endpoint,
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		Query:    r.URL.Query(),
+		Header:   r.Header.Clone(),
+	})
+
+	limited := false
+	if s.rateLimit > 0 {
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.rateLimit))
+		w.Header().Set("X-RateLimit-Path", r.URL.Path)
+		if s.rateRemaining > 0 {
+			s.rateRemaining--
+		} else {
+			limited = true
+		}
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.rateRemaining))
+	}
+
+	injected, injectedOK := s.errors[endpoint]
+	if !injectedOK {
+		injected, injectedOK = s.errors[""]
+	}
+	fixture := s.fixtures[endpoint]
+	s.mu.Unlock()
+
+	meta := foursquarego.Meta{Code: http.StatusOK, RequestID: requestID}
+	query := r.URL.Query()
+
+	switch {
+	case query.Get("client_id") == "" || (query.Get("client_secret") == "" && query.Get("access_token") == ""):
+		s.writeError(w, foursquarego.Meta{Code: http.StatusBadRequest, ErrorType: "invalid_auth", ErrorDetail: "Missing access credentials."}, requestID)
+	case limited:
+		s.writeError(w, foursquarego.Meta{Code: http.StatusForbidden, ErrorType: "rate_limit_exceeded", ErrorDetail: "Quota exceeded"}, requestID)
+	case injectedOK:
+		s.writeError(w, injected, requestID)
+	case endpoint == "":
+		s.writeError(w, foursquarego.Meta{Code: http.StatusNotFound, ErrorType: "endpoint_error", ErrorDetail: "Endpoint not found"}, requestID)
+	case fixture != nil:
+		writeJSON(w, http.StatusOK, json.RawMessage(fixture))
+	case endpoint == foursquarego.EndpointVenueDetails:
+		s.serveDetails(w, meta, id)
+	case endpoint == foursquarego.EndpointSearch:
+		s.serveSearch(w, meta, query)
+	default:
+		writeJSON(w, http.StatusOK, json.RawMessage(fixtures[fixtureEndpoints[endpoint]]))
+	}
+}
+
+func (s *Server) serveDetails(w http.ResponseWriter, meta foursquarego.Meta, id string) {
+	s.mu.Lock()
+	venue, ok := s.venues[id]
+	s.mu.Unlock()
+
+	if !ok {
+		s.writeError(w, foursquarego.Meta{
+			Code:        http.StatusBadRequest,
+			ErrorType:   "param_error",
+			ErrorDetail: "Value " + id + " is invalid for venue id",
+		}, meta.RequestID)
+		return
+	}
+	writeResponse(w, meta, map[string]interface{}{"venue": venue})
+}
+
+func (s *Server) serveSearch(w http.ResponseWriter, meta foursquarego.Meta, query url.Values) {
+	s.mu.Lock()
+	var venues []foursquarego.Venue
+	for _, v := range s.venues {
+		if matches(v, query) {
+			venues = append(venues, v)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(venues, func(i, j int) bool { return venues[i].ID < venues[j].ID })
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit <= 0 {
+		limit = 30
+	}
+	if len(venues) > limit {
+		venues = venues[:limit]
+	}
+	if venues == nil {
+		venues = []foursquarego.Venue{}
+	}
+	writeResponse(w, meta, map[string]interface{}{"venues": venues})
+}
+
+// matches filters the store by query, sw/ne, ll with radius and
+// categoryId. Other parameters such as intent are not supported and
+// results are sorted by id rather than relevance or distance.
+func matches(v foursquarego.Venue, query url.Values) bool {
+	if q := strings.ToLower(query.Get("query")); q != "" && !strings.Contains(strings.ToLower(v.Name), q) {
+		return false
+	}
+	point := geo.Point(v)
+
+	if sw, ne := query.Get("sw"), query.Get("ne"); sw != "" && ne != "" {
+		swLL, err1 := foursquarego.ParseLatLong(sw)
+		neLL, err2 := foursquarego.ParseLatLong(ne)
+		if err1 != nil || err2 != nil || !geo.Contains(foursquarego.Bounds{Sw: swLL, Ne: neLL}, point) {
+			return false
+		}
+	}
+
+	if ll, radius := query.Get("ll"), query.Get("radius"); ll != "" && radius != "" {
+		center, err1 := foursquarego.ParseLatLong(ll)
+		meters, err2 := strconv.ParseFloat(radius, 64)
+		if err1 != nil || err2 != nil || geo.Distance(center, point) > meters {
+			return false
+		}
+	}
+
+	// categoryId is sent repeated or comma separated.
+	var ids []string
+	for _, value := range query["categoryId"] {
+		ids = append(ids, strings.Split(value, ",")...)
+	}
+	if len(ids) == 0 {
+		return true
+	}
+	for _, id := range ids {
+		for _, c := range v.Categories {
+			if c.ID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) writeError(w http.ResponseWriter, meta foursquarego.Meta, requestID string) {
+	if meta.RequestID == "" {
+		meta.RequestID = requestID
+	}
+	code := meta.Code
+	if code == 0 {
+		code = http.StatusInternalServerError
+		meta.Code = code
+	}
+	writeJSON(w, code, map[string]interface{}{
+		"meta":     meta,
+		"response": struct{}{},
+	})
+}
+
+func writeResponse(w http.ResponseWriter, meta foursquarego.Meta, response interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"meta": meta,
+		"notifications": []interface{}{
+			map[string]interface{}{"type": "notificationTray", "item": map[string]int{"unreadCount": 0}},
+		},
+		"response": response,
+	})
+}
+
+// LoadFixture decodes the response of the json/venues fixture name, without
+// .json, into v and fails t if it is missing or does not decode.
+func LoadFixture(t testing.TB, name string, v interface{}) {
+	t.Helper()
+	body, ok := fixtures[name]
+	if !ok {
+		t.Fatalf("foursquaretest: no fixture %q", name)
+	}
+	resp := struct {
+		Response interface{} `json:"response"`
+	}{v}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("foursquaretest: decoding fixture %q: %v", name, err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// seed fills the store with the venues from the details and search
+// fixtures.
+func (s *Server) seed() {
+	var details struct {
+		Response struct {
+			Venue foursquarego.Venue `json:"venue"`
+		} `json:"response"`
+	}
+	json.Unmarshal([]byte(fixtures["details"]), &details)
+	s.venues[details.Response.Venue.ID] = details.Response.Venue
+
+	var search struct {
+		Response struct {
+			Venues []foursquarego.Venue `json:"venues"`
+		} `json:"response"`
+	}
+	json.Unmarshal([]byte(fixtures["search"]), &search)
+	for _, v := range search.Response.Venues {
+		s.venues[v.ID] = v
+	}
+}
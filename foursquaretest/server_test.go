@@ -0,0 +1,185 @@
+package foursquaretest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_details(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	venue, resp, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Threes Brewing", venue.Name)
+
+	server.AddVenue(foursquarego.Venue{ID: "v1", Name: "Joe's Pizza"})
+	venue, _, err = client.Venues.Details("v1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Joe's Pizza", venue.Name)
+
+	_, resp, err = client.Venues.Details("missing")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	if apiErr, ok := err.(*foursquarego.APIError); assert.True(t, ok) {
+		assert.Equal(t, "param_error", apiErr.Meta.ErrorType)
+	}
+
+	assert.Len(t, server.Requests("venues.details"), 3)
+}
+
+func TestServer_search(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddVenue(
+		foursquarego.Venue{ID: "a", Name: "Pizza Place", Location: foursquarego.Location{Lat: 40.7, Lng: -74}},
+		foursquarego.Venue{ID: "b", Name: "Burger Place", Location: foursquarego.Location{Lat: 40.7, Lng: -74}},
+	)
+
+	venues, _, err := client.Venues.Search(&foursquarego.VenueSearchParams{
+		Query: "pizza",
+		Point: &foursquarego.LatLong{Lat: 40.7, Lng: -74},
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, venues, 1) {
+		assert.Equal(t, "a", venues[0].ID)
+	}
+	server.AssertRequested(t, "venues.search", map[string]string{
+		"query":     "pizza",
+		"ll":        "40.7,-74",
+		"client_id": foursquaretest.ClientID,
+	})
+
+	venues, _, err = client.Venues.Search(&foursquarego.VenueSearchParams{
+		Intent: foursquarego.IntentBrowse,
+		Bounds: &foursquarego.Bounds{
+			Sw: foursquarego.LatLong{Lat: 40.77, Lng: -73.91},
+			Ne: foursquarego.LatLong{Lat: 40.79, Lng: -73.89},
+		},
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, venues, 1) {
+		assert.Equal(t, "SingleCut Beersmiths", venues[0].Name)
+	}
+}
+
+func TestServer_searchRadiusAndCategories(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	server.ClearVenues()
+	client := server.Client()
+
+	pizza := []foursquarego.Category{{ID: "pizza"}}
+	server.AddVenue(
+		foursquarego.Venue{ID: "near", Categories: pizza, Location: foursquarego.Location{Lat: 40.7, Lng: -74}},
+		foursquarego.Venue{ID: "far", Categories: pizza, Location: foursquarego.Location{Lat: 40.8, Lng: -74}},
+		foursquarego.Venue{ID: "bar", Categories: []foursquarego.Category{{ID: "bar"}}, Location: foursquarego.Location{Lat: 40.7, Lng: -74.001}},
+		foursquarego.Venue{ID: "cafe", Categories: []foursquarego.Category{{ID: "cafe"}}, Location: foursquarego.Location{Lat: 40.7, Lng: -74.001}},
+	)
+
+	ids := func(venues []foursquarego.Venue) []string {
+		var ids []string
+		for _, v := range venues {
+			ids = append(ids, v.ID)
+		}
+		return ids
+	}
+
+	venues, _, err := client.Venues.Search(&foursquarego.VenueSearchParams{
+		Point:  &foursquarego.LatLong{Lat: 40.7, Lng: -74},
+		Radius: 1000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bar", "cafe", "near"}, ids(venues))
+
+	venues, _, err = client.Venues.Search(&foursquarego.VenueSearchParams{
+		Point:      &foursquarego.LatLong{Lat: 40.7, Lng: -74},
+		CategoryID: []string{"bar", "pizza"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bar", "far", "near"}, ids(venues))
+}
+
+func TestServer_fixtures(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	cats, _, err := client.Venues.Categories()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, cats)
+
+	tips, _, err := client.Venues.Tips(&foursquarego.VenueTipsParams{VenueID: "v1"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, tips)
+	server.AssertRequested(t, "venues.tips", map[string]string{"limit": ""})
+
+	server.SetFixture("venues.categories", []byte(`{"meta":{"code":200},"response":{"categories":[]}}`))
+	cats, _, err = client.Venues.Categories()
+	assert.Nil(t, err)
+	assert.Empty(t, cats)
+}
+
+func TestServer_InjectError(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.InjectError("venues.hours", foursquarego.Meta{
+		Code:        http.StatusInternalServerError,
+		ErrorType:   "server_error",
+		ErrorDetail: "Foursquare servers are experiencing problems.",
+	})
+
+	_, resp, err := client.Venues.Hours("v1")
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	if apiErr, ok := err.(*foursquarego.APIError); assert.True(t, ok) {
+		assert.Equal(t, "server_error", apiErr.Meta.ErrorType)
+		assert.NotEmpty(t, apiErr.Meta.RequestID)
+	}
+
+	_, _, err = client.Venues.Likes("v1")
+	assert.Nil(t, err)
+
+	server.ClearErrors()
+	_, _, err = client.Venues.Hours("v1")
+	assert.Nil(t, err)
+}
+
+func TestServer_SetRateLimit(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.SetRateLimit(500, 1)
+
+	var result foursquarego.Result
+	_, _, err := client.Venues.WithResult(&result).Likes("v1")
+	assert.Nil(t, err)
+	assert.Equal(t, 500, result.RateLimit.Limit)
+	assert.Equal(t, 0, result.RateLimit.Remaining)
+
+	_, resp, err := client.Venues.Likes("v1")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	if apiErr, ok := err.(*foursquarego.APIError); assert.True(t, ok) {
+		assert.Equal(t, "rate_limit_exceeded", apiErr.Meta.ErrorType)
+	}
+}
+
+func TestServer_auth(t *testing.T) {
+	server := foursquaretest.NewServer()
+	defer server.Close()
+
+	client := foursquarego.NewClient(server.HTTPClient(), "foursquare", "", "", "")
+	_, _, err := client.Venues.Categories()
+	if apiErr, ok := err.(*foursquarego.APIError); assert.True(t, ok) {
+		assert.Equal(t, "invalid_auth", apiErr.Meta.ErrorType)
+	}
+}
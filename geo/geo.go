@@ -0,0 +1,136 @@
+// Package geo has distance and bounding box helpers for foursquarego
+// venues and coordinates.
+//
+//	venues, _, err := client.Venues.Trending(params)
+//	geo.SortByDistance(venues, foursquarego.LatLong{Lat: 40.7, Lng: -74})
+package geo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/peppage/foursquarego"
+)
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// Distance is the great circle distance in meters between a and b using
+// the haversine formula.
+func Distance(a, b foursquarego.LatLong) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Bearing is the initial bearing in degrees, 0 to 360 clockwise from
+// north, to travel from a to b.
+func Bearing(a, b foursquarego.LatLong) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLng := radians(b.Lng - a.Lng)
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// BoundsAround is the smallest box containing the circle of radius meters
+// around center. Latitudes are clamped at the poles and longitudes wrap
+// at the antimeridian, so Sw.Lng can be greater than Ne.Lng.
+func BoundsAround(center foursquarego.LatLong, radius float64) foursquarego.Bounds {
+	dLat := degrees(radius / EarthRadius)
+	south := math.Max(center.Lat-dLat, -90)
+	north := math.Min(center.Lat+dLat, 90)
+
+	if south == -90 || north == 90 {
+		return foursquarego.Bounds{
+			Sw: foursquarego.LatLong{Lat: south, Lng: -180},
+			Ne: foursquarego.LatLong{Lat: north, Lng: 180},
+		}
+	}
+
+	dLng := degrees(radius / (EarthRadius * math.Cos(radians(center.Lat))))
+	if dLng >= 180 {
+		return foursquarego.Bounds{
+			Sw: foursquarego.LatLong{Lat: south, Lng: -180},
+			Ne: foursquarego.LatLong{Lat: north, Lng: 180},
+		}
+	}
+
+	return foursquarego.Bounds{
+		Sw: foursquarego.LatLong{Lat: south, Lng: wrap(center.Lng - dLng)},
+		Ne: foursquarego.LatLong{Lat: north, Lng: wrap(center.Lng + dLng)},
+	}
+}
+
+// Contains reports if p is inside b. Bounds crossing the antimeridian
+// have Sw.Lng greater than Ne.Lng.
+func Contains(b foursquarego.Bounds, p foursquarego.LatLong) bool {
+	if p.Lat < b.Sw.Lat || p.Lat > b.Ne.Lat {
+		return false
+	}
+	if b.Sw.Lng <= b.Ne.Lng {
+		return p.Lng >= b.Sw.Lng && p.Lng <= b.Ne.Lng
+	}
+	return p.Lng >= b.Sw.Lng || p.Lng <= b.Ne.Lng
+}
+
+// Point is the coordinates of the venue's location.
+func Point(v foursquarego.Venue) foursquarego.LatLong {
+	return foursquarego.LatLong{Lat: v.Location.Lat, Lng: v.Location.Lng}
+}
+
+// VenueDistance is the distance in meters from p to the venue. Unlike
+// Location.Distance it does not need ll to be sent with the request.
+func VenueDistance(v foursquarego.Venue, p foursquarego.LatLong) float64 {
+	return Distance(p, Point(v))
+}
+
+// SortByDistance sorts the venues closest to p first.
+func SortByDistance(venues []foursquarego.Venue, p foursquarego.LatLong) {
+	sort.SliceStable(venues, func(i, j int) bool {
+		return VenueDistance(venues[i], p) < VenueDistance(venues[j], p)
+	})
+}
+
+// SortRecommendsByDistance sorts the items of an explore Recommendation
+// closest to p first.
+func SortRecommendsByDistance(items []foursquarego.Recommend, p foursquarego.LatLong) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return VenueDistance(items[i].Venue, p) < VenueDistance(items[j].Venue, p)
+	})
+}
+
+// WithinRadius returns the venues that are at most radius meters from p.
+func WithinRadius(venues []foursquarego.Venue, p foursquarego.LatLong, radius float64) []foursquarego.Venue {
+	var within []foursquarego.Venue
+	for _, v := range venues {
+		if VenueDistance(v, p) <= radius {
+			within = append(within, v)
+		}
+	}
+	return within
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+// wrap puts a longitude back into -180 to 180.
+func wrap(lng float64) float64 {
+	if lng > 180 {
+		return lng - 360
+	}
+	if lng < -180 {
+		return lng + 360
+	}
+	return lng
+}
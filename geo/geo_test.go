@@ -0,0 +1,62 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	threes   = foursquarego.LatLong{Lat: 40.67979901271337, Lng: -73.98215935484912}
+	empire   = foursquarego.LatLong{Lat: 40.748817, Lng: -73.985428}
+	london   = foursquarego.LatLong{Lat: 51.5074, Lng: -0.1278}
+	newYork  = foursquarego.LatLong{Lat: 40.7128, Lng: -74.0060}
+	times    = foursquarego.LatLong{Lat: 40.758, Lng: -73.9855}
+	fiji     = foursquarego.LatLong{Lat: -17.7134, Lng: 179.9}
+	fijiEast = foursquarego.LatLong{Lat: -17.7134, Lng: -179.9}
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 7680, Distance(threes, empire), 10)
+	assert.InDelta(t, 5570000, Distance(newYork, london), 5000)
+	assert.Equal(t, 0.0, Distance(london, london))
+}
+
+func TestBearing(t *testing.T) {
+	assert.InDelta(t, 358, Bearing(threes, empire), 1)
+	assert.InDelta(t, 51, Bearing(newYork, london), 1)
+}
+
+func TestBoundsAround(t *testing.T) {
+	b := BoundsAround(empire, 1000)
+	assert.True(t, Contains(b, empire))
+	assert.InDelta(t, 1000, Distance(empire, foursquarego.LatLong{Lat: b.Ne.Lat, Lng: empire.Lng}), 1)
+	assert.InDelta(t, 1000, Distance(empire, foursquarego.LatLong{Lat: empire.Lat, Lng: b.Ne.Lng}), 1)
+	assert.False(t, Contains(b, threes))
+
+	b = BoundsAround(fiji, 50000)
+	assert.True(t, b.Sw.Lng > b.Ne.Lng)
+	assert.True(t, Contains(b, fijiEast))
+	assert.False(t, Contains(b, london))
+}
+
+func TestSortByDistance(t *testing.T) {
+	venues := []foursquarego.Venue{
+		{ID: "london", Location: foursquarego.Location{Lat: london.Lat, Lng: london.Lng}},
+		{ID: "threes", Location: foursquarego.Location{Lat: threes.Lat, Lng: threes.Lng}},
+		{ID: "empire", Location: foursquarego.Location{Lat: empire.Lat, Lng: empire.Lng}},
+	}
+
+	SortByDistance(venues, times)
+	assert.Equal(t, "empire", venues[0].ID)
+	assert.Equal(t, "threes", venues[1].ID)
+	assert.Equal(t, "london", venues[2].ID)
+
+	within := WithinRadius(venues, times, 10000)
+	assert.Len(t, within, 2)
+
+	items := []foursquarego.Recommend{{Venue: venues[2]}, {Venue: venues[0]}}
+	SortRecommendsByDistance(items, times)
+	assert.Equal(t, "empire", items[0].Venue.ID)
+}
@@ -0,0 +1,261 @@
+// Package geojson converts foursquarego venues to and from GeoJSON
+// features so they can be plotted on maps such as Mapbox.
+//
+//	venues, _, err := client.Venues.Search(params)
+//	b, err := json.Marshal(geojson.FromVenues(venues))
+package geojson
+
+import (
+	"fmt"
+
+	"github.com/peppage/foursquarego"
+)
+
+// Types of GeoJSON objects
+const (
+	TypeFeature           = "Feature"
+	TypeFeatureCollection = "FeatureCollection"
+	TypePoint             = "Point"
+)
+
+// Property is a group of venue fields added to the properties of a Feature.
+type Property string
+
+// Options for Property
+const (
+	// PropertyName adds name.
+	PropertyName Property = "name"
+	// PropertyCategory adds category and categoryId of the primary category.
+	PropertyCategory Property = "category"
+	// PropertyRating adds rating and ratingColor.
+	PropertyRating Property = "rating"
+	// PropertyPriceTier adds priceTier.
+	PropertyPriceTier Property = "priceTier"
+	// PropertyAddress adds address, crossStreet, city, state, postalCode
+	// and country.
+	PropertyAddress Property = "address"
+)
+
+// DefaultProperties are used when no properties are given.
+var DefaultProperties = []Property{
+	PropertyName,
+	PropertyCategory,
+	PropertyRating,
+	PropertyPriceTier,
+	PropertyAddress,
+}
+
+// Geometry is a GeoJSON Point, Coordinates are [lng, lat].
+type Geometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// Feature is a GeoJSON Feature for a single venue. The ID is the venue ID.
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// FeatureCollection is a GeoJSON FeatureCollection. BBox is
+// [west, south, east, north].
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	BBox     []float64 `json:"bbox,omitempty"`
+	Features []Feature `json:"features"`
+}
+
+// FromVenue makes a Feature of the venue with the properties, or the
+// DefaultProperties if none are given.
+func FromVenue(v foursquarego.Venue, props ...Property) Feature {
+	f := newFeature(v.ID, v.Location)
+	for _, p := range properties(props) {
+		switch p {
+		case PropertyName:
+			f.Properties["name"] = v.Name
+		case PropertyCategory:
+			addCategory(f.Properties, v.Categories)
+		case PropertyRating:
+			if v.Rating != 0 {
+				f.Properties["rating"] = v.Rating
+				f.Properties["ratingColor"] = v.RatingColor
+			}
+		case PropertyPriceTier:
+			if v.Price.Tier != 0 {
+				f.Properties["priceTier"] = v.Price.Tier
+			}
+		case PropertyAddress:
+			addAddress(f.Properties, v.Location)
+		}
+	}
+	return f
+}
+
+// FromMiniVenue makes a Feature of the mini venue. Rating and price tier
+// are not part of a MiniVenue and are skipped.
+func FromMiniVenue(v foursquarego.MiniVenue, props ...Property) Feature {
+	f := newFeature(v.ID, v.Location)
+	for _, p := range properties(props) {
+		switch p {
+		case PropertyName:
+			f.Properties["name"] = v.Name
+		case PropertyCategory:
+			addCategory(f.Properties, v.Category)
+		case PropertyAddress:
+			addAddress(f.Properties, v.Location)
+		}
+	}
+	return f
+}
+
+// FromVenues makes a FeatureCollection of the venues with a bbox around
+// all of them.
+func FromVenues(venues []foursquarego.Venue, props ...Property) FeatureCollection {
+	fc := FeatureCollection{
+		Type:     TypeFeatureCollection,
+		Features: []Feature{},
+	}
+	for _, v := range venues {
+		fc.Features = append(fc.Features, FromVenue(v, props...))
+	}
+	fc.BBox = featuresBBox(fc.Features)
+	return fc
+}
+
+// BBox is the [west, south, east, north] bbox of the suggested bounds of
+// an explore response.
+func BBox(b foursquarego.SuggestedBounds) []float64 {
+	return []float64{b.Sw.Lng, b.Sw.Lat, b.Ne.Lng, b.Ne.Lat}
+}
+
+// ToVenue reads a Feature made by FromVenue back into a Venue. Only the
+// fields that were added as properties are set.
+func ToVenue(f Feature) (foursquarego.Venue, error) {
+	v := foursquarego.Venue{ID: f.ID}
+	if f.Type != TypeFeature {
+		return v, fmt.Errorf("geojson: type %q is not a Feature", f.Type)
+	}
+	if f.Geometry.Type != TypePoint || len(f.Geometry.Coordinates) < 2 {
+		return v, fmt.Errorf("geojson: feature %s is not a Point", f.ID)
+	}
+	v.Location.Lng = f.Geometry.Coordinates[0]
+	v.Location.Lat = f.Geometry.Coordinates[1]
+
+	p := f.Properties
+	v.Name = stringProp(p, "name")
+	if name, id := stringProp(p, "category"), stringProp(p, "categoryId"); name != "" || id != "" {
+		v.Categories = []foursquarego.Category{{ID: id, Name: name, Primary: true}}
+	}
+	v.Rating = floatProp(p, "rating")
+	v.RatingColor = stringProp(p, "ratingColor")
+	v.Price.Tier = int(floatProp(p, "priceTier"))
+	v.Location.Address = stringProp(p, "address")
+	v.Location.CrossStreet = stringProp(p, "crossStreet")
+	v.Location.City = stringProp(p, "city")
+	v.Location.State = stringProp(p, "state")
+	v.Location.PostalCode = stringProp(p, "postalCode")
+	v.Location.Country = stringProp(p, "country")
+	return v, nil
+}
+
+// ToVenues reads a FeatureCollection back into venues.
+func ToVenues(fc FeatureCollection) ([]foursquarego.Venue, error) {
+	if fc.Type != TypeFeatureCollection {
+		return nil, fmt.Errorf("geojson: type %q is not a FeatureCollection", fc.Type)
+	}
+	venues := make([]foursquarego.Venue, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		v, err := ToVenue(f)
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, v)
+	}
+	return venues, nil
+}
+
+func newFeature(id string, l foursquarego.Location) Feature {
+	return Feature{
+		Type: TypeFeature,
+		ID:   id,
+		Geometry: Geometry{
+			Type:        TypePoint,
+			Coordinates: []float64{l.Lng, l.Lat},
+		},
+		Properties: map[string]interface{}{},
+	}
+}
+
+func properties(props []Property) []Property {
+	if len(props) == 0 {
+		return DefaultProperties
+	}
+	return props
+}
+
+func addCategory(p map[string]interface{}, categories []foursquarego.Category) {
+	for _, c := range categories {
+		if c.Primary || len(categories) == 1 {
+			p["category"] = c.Name
+			p["categoryId"] = c.ID
+			return
+		}
+	}
+}
+
+func addAddress(p map[string]interface{}, l foursquarego.Location) {
+	for key, value := range map[string]string{
+		"address":     l.Address,
+		"crossStreet": l.CrossStreet,
+		"city":        l.City,
+		"state":       l.State,
+		"postalCode":  l.PostalCode,
+		"country":     l.Country,
+	} {
+		if value != "" {
+			p[key] = value
+		}
+	}
+}
+
+func featuresBBox(features []Feature) []float64 {
+	if len(features) == 0 {
+		return nil
+	}
+	c := features[0].Geometry.Coordinates
+	bbox := []float64{c[0], c[1], c[0], c[1]}
+	for _, f := range features[1:] {
+		c := f.Geometry.Coordinates
+		if c[0] < bbox[0] {
+			bbox[0] = c[0]
+		}
+		if c[1] < bbox[1] {
+			bbox[1] = c[1]
+		}
+		if c[0] > bbox[2] {
+			bbox[2] = c[0]
+		}
+		if c[1] > bbox[3] {
+			bbox[3] = c[1]
+		}
+	}
+	return bbox
+}
+
+func stringProp(p map[string]interface{}, key string) string {
+	s, _ := p[key].(string)
+	return s
+}
+
+// floatProp reads a number, json decodes every number as a float64.
+func floatProp(p map[string]interface{}, key string) float64 {
+	switch n := p[key].(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	}
+	return 0
+}
@@ -0,0 +1,98 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func detailsVenue(t *testing.T) foursquarego.Venue {
+	var resp struct {
+		Venue foursquarego.Venue `json:"venue"`
+	}
+	foursquaretest.LoadFixture(t, "details", &resp)
+	return resp.Venue
+}
+
+func TestFromVenue(t *testing.T) {
+	f := FromVenue(detailsVenue(t))
+
+	b, err := json.Marshal(f)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "Feature",
+		"id": "5414d0a6498ea3d31a3c64cf",
+		"geometry": {"type": "Point", "coordinates": [-73.98215935484912, 40.67979901271337]},
+		"properties": {
+			"name": "Threes Brewing",
+			"category": "Brewery",
+			"categoryId": "50327c8591d4c4b30a586d5d",
+			"rating": 9.4,
+			"ratingColor": "00B551",
+			"priceTier": 2,
+			"address": "333 Douglass St",
+			"crossStreet": "at 4th Ave",
+			"city": "Brooklyn",
+			"state": "NY",
+			"postalCode": "11217",
+			"country": "United States"
+		}
+	}`, string(b))
+
+	f = FromVenue(detailsVenue(t), PropertyName)
+	assert.Equal(t, map[string]interface{}{"name": "Threes Brewing"}, f.Properties)
+}
+
+func TestFromMiniVenue(t *testing.T) {
+	f := FromMiniVenue(foursquarego.MiniVenue{
+		ID:       "5a187743ccad6b307315e6fe",
+		Name:     "Foursquare HQ",
+		Location: foursquarego.Location{Lat: 40.74, Lng: -73.99},
+		Category: []foursquarego.Category{{ID: "4bf58dd8d48988d125941735", Name: "Tech Startup", Primary: true}},
+	})
+
+	assert.Equal(t, []float64{-73.99, 40.74}, f.Geometry.Coordinates)
+	assert.Equal(t, "Tech Startup", f.Properties["category"])
+	assert.NotContains(t, f.Properties, "rating")
+}
+
+func TestRoundTrip(t *testing.T) {
+	venue := detailsVenue(t)
+	other := foursquarego.Venue{ID: "other", Name: "Other", Location: foursquarego.Location{Lat: 40.7, Lng: -74}}
+
+	fc := FromVenues([]foursquarego.Venue{venue, other})
+	assert.Equal(t, []float64{-74, 40.67979901271337, -73.98215935484912, 40.7}, fc.BBox)
+
+	b, err := json.Marshal(fc)
+	assert.Nil(t, err)
+
+	var parsed FeatureCollection
+	assert.Nil(t, json.Unmarshal(b, &parsed))
+	venues, err := ToVenues(parsed)
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 2)
+	assert.Equal(t, venue.ID, venues[0].ID)
+	assert.Equal(t, venue.Name, venues[0].Name)
+	assert.Equal(t, venue.Location.Lat, venues[0].Location.Lat)
+	assert.Equal(t, venue.Location.Lng, venues[0].Location.Lng)
+	assert.Equal(t, venue.Location.City, venues[0].Location.City)
+	assert.Equal(t, venue.Rating, venues[0].Rating)
+	assert.Equal(t, venue.Price.Tier, venues[0].Price.Tier)
+	assert.Equal(t, "Brewery", venues[0].Categories[0].Name)
+	assert.Equal(t, "Other", venues[1].Name)
+
+	_, err = ToVenue(Feature{Type: TypeFeature, Geometry: Geometry{Type: "LineString"}})
+	assert.Error(t, err)
+}
+
+func TestBBox(t *testing.T) {
+	bbox := BBox(foursquarego.SuggestedBounds{
+		Ne: foursquarego.LatLong{Lat: 40.77, Lng: -73.97},
+		Sw: foursquarego.LatLong{Lat: 40.76, Lng: -73.99},
+	})
+	assert.Equal(t, []float64{-73.99, 40.76, -73.97, 40.77}, bbox)
+}
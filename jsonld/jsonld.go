@@ -0,0 +1,295 @@
+// Package jsonld converts a venue with its menu and hours into schema.org
+// JSON-LD for the structured data of restaurant pages.
+//
+//	hours, _, err := client.Venues.Hours(venue.ID)
+//	m, _, err := client.Venues.Menu(venue.ID)
+//	err = jsonld.WriteScript(w, jsonld.FromVenue(*venue, m, hours))
+package jsonld
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/menu"
+)
+
+// Context is the @context of the top level object.
+const Context = "https://schema.org"
+
+// Types of schema.org objects
+const (
+	TypeRestaurant                = "Restaurant"
+	TypePostalAddress             = "PostalAddress"
+	TypeGeoCoordinates            = "GeoCoordinates"
+	TypeOpeningHoursSpecification = "OpeningHoursSpecification"
+	TypeAggregateRating           = "AggregateRating"
+	TypeMenu                      = "Menu"
+	TypeMenuSection               = "MenuSection"
+	TypeMenuItem                  = "MenuItem"
+	TypeOffer                     = "Offer"
+)
+
+// Restaurant is a schema.org Restaurant. Type can be changed to another
+// FoodEstablishment such as BarOrPub.
+type Restaurant struct {
+	Context                   string                      `json:"@context,omitempty"`
+	Type                      string                      `json:"@type"`
+	ID                        string                      `json:"@id,omitempty"`
+	Name                      string                      `json:"name"`
+	Description               string                      `json:"description,omitempty"`
+	URL                       string                      `json:"url,omitempty"`
+	SameAs                    []string                    `json:"sameAs,omitempty"`
+	Telephone                 string                      `json:"telephone,omitempty"`
+	Image                     string                      `json:"image,omitempty"`
+	PriceRange                string                      `json:"priceRange,omitempty"`
+	ServesCuisine             []string                    `json:"servesCuisine,omitempty"`
+	Address                   *PostalAddress              `json:"address,omitempty"`
+	Geo                       *GeoCoordinates             `json:"geo,omitempty"`
+	OpeningHoursSpecification []OpeningHoursSpecification `json:"openingHoursSpecification,omitempty"`
+	AggregateRating           *AggregateRating            `json:"aggregateRating,omitempty"`
+	HasMenu                   []Menu                      `json:"hasMenu,omitempty"`
+}
+
+// PostalAddress is a schema.org PostalAddress.
+type PostalAddress struct {
+	Type            string `json:"@type"`
+	StreetAddress   string `json:"streetAddress,omitempty"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+	AddressRegion   string `json:"addressRegion,omitempty"`
+	PostalCode      string `json:"postalCode,omitempty"`
+	AddressCountry  string `json:"addressCountry,omitempty"`
+}
+
+// GeoCoordinates is a schema.org GeoCoordinates.
+type GeoCoordinates struct {
+	Type      string  `json:"@type"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// OpeningHoursSpecification is a schema.org OpeningHoursSpecification.
+// Opens and Closes are hh:mm, Closes is earlier than Opens when the venue
+// closes after midnight.
+type OpeningHoursSpecification struct {
+	Type      string   `json:"@type"`
+	DayOfWeek []string `json:"dayOfWeek"`
+	Opens     string   `json:"opens"`
+	Closes    string   `json:"closes"`
+}
+
+// AggregateRating is a schema.org AggregateRating. Foursquare rates out
+// of 10.
+type AggregateRating struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	BestRating  float64 `json:"bestRating"`
+	RatingCount int     `json:"ratingCount,omitempty"`
+}
+
+// Menu is a schema.org Menu.
+type Menu struct {
+	Type           string        `json:"@type"`
+	Name           string        `json:"name,omitempty"`
+	Description    string        `json:"description,omitempty"`
+	HasMenuSection []MenuSection `json:"hasMenuSection,omitempty"`
+}
+
+// MenuSection is a schema.org MenuSection.
+type MenuSection struct {
+	Type        string     `json:"@type"`
+	Name        string     `json:"name"`
+	HasMenuItem []MenuItem `json:"hasMenuItem,omitempty"`
+}
+
+// MenuItem is a schema.org MenuItem.
+type MenuItem struct {
+	Type        string  `json:"@type"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Offers      []Offer `json:"offers,omitempty"`
+}
+
+// Offer is a schema.org Offer with the price of a MenuItem.
+type Offer struct {
+	Type          string `json:"@type"`
+	Price         string `json:"price"`
+	PriceCurrency string `json:"priceCurrency,omitempty"`
+}
+
+// FromVenue makes a Restaurant of the venue. menu and hours are the
+// responses of VenueService.Menu and VenueService.Hours and may be nil.
+func FromVenue(v foursquarego.Venue, m *foursquarego.MenuResp, hours *foursquarego.VenueHoursResp) Restaurant {
+	r := Restaurant{
+		Context:     Context,
+		Type:        TypeRestaurant,
+		ID:          v.CanonicalURL,
+		Name:        v.Name,
+		Description: v.Description,
+		URL:         v.URL,
+		Telephone:   v.Contact.FormattedPhone,
+		Image:       v.BestPhoto.URL(foursquarego.PhotoOriginal),
+		Address:     address(v.Location),
+	}
+	if r.Telephone == "" {
+		r.Telephone = v.Contact.Phone
+	}
+	if v.CanonicalURL != "" {
+		r.SameAs = []string{v.CanonicalURL}
+	}
+	if v.Location.Lat != 0 || v.Location.Lng != 0 {
+		r.Geo = &GeoCoordinates{Type: TypeGeoCoordinates, Latitude: v.Location.Lat, Longitude: v.Location.Lng}
+	}
+	if v.Price.Tier > 0 {
+		symbol := v.Price.Currency
+		if symbol == "" {
+			symbol = "$"
+		}
+		r.PriceRange = strings.Repeat(symbol, v.Price.Tier)
+	}
+	for _, c := range v.Categories {
+		if cuisine := strings.TrimSuffix(c.Name, " Restaurant"); cuisine != c.Name && cuisine != "" {
+			r.ServesCuisine = append(r.ServesCuisine, cuisine)
+		}
+	}
+	if v.Rating > 0 {
+		r.AggregateRating = &AggregateRating{
+			Type:        TypeAggregateRating,
+			RatingValue: v.Rating,
+			BestRating:  10,
+			RatingCount: v.RatingSignals,
+		}
+	}
+	if hours != nil {
+		r.OpeningHoursSpecification = OpeningHours(hours.Hours)
+	}
+	r.HasMenu = Menus(m, &v)
+	return r
+}
+
+func address(l foursquarego.Location) *PostalAddress {
+	a := &PostalAddress{
+		Type:            TypePostalAddress,
+		StreetAddress:   l.Address,
+		AddressLocality: l.City,
+		AddressRegion:   l.State,
+		PostalCode:      l.PostalCode,
+		AddressCountry:  l.Cc,
+	}
+	if *a == (PostalAddress{Type: TypePostalAddress}) {
+		return nil
+	}
+	return a
+}
+
+// days are the schema.org DayOfWeek names, foursquare numbers Monday 1
+// through Sunday 7.
+var days = []string{"", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// OpeningHours converts the hours of VenueService.Hours. Every open range
+// of a timeframe becomes one specification for all of its days.
+func OpeningHours(h foursquarego.HoursResp) []OpeningHoursSpecification {
+	var specs []OpeningHoursSpecification
+	for _, tf := range h.TimeFrames {
+		var dayNames []string
+		for _, d := range tf.Days {
+			if d >= 1 && d <= 7 {
+				dayNames = append(dayNames, days[d])
+			}
+		}
+		if len(dayNames) == 0 {
+			continue
+		}
+		for _, o := range tf.Open {
+			specs = append(specs, OpeningHoursSpecification{
+				Type:      TypeOpeningHoursSpecification,
+				DayOfWeek: dayNames,
+				Opens:     clock(o.Start),
+				Closes:    clock(o.End),
+			})
+		}
+	}
+	return specs
+}
+
+// clock turns foursquare's hhmm into hh:mm. A leading + means the next
+// day, which is dropped.
+func clock(t string) string {
+	t = strings.TrimPrefix(t, "+")
+	if len(t) != 4 {
+		return t
+	}
+	return t[:2] + ":" + t[2:]
+}
+
+// Menus converts the menus with prices in the currency of the venue,
+// which may be nil. Prices that cannot be parsed, like "Market Price", are
+// left out, as are menus and sections without entries.
+func Menus(resp *foursquarego.MenuResp, v *foursquarego.Venue) []Menu {
+	if resp == nil {
+		return nil
+	}
+
+	// Flatten drops the menu descriptions.
+	descriptions := map[string]string{}
+	for _, m := range resp.Menus.Items {
+		descriptions[m.MenuID] = m.Description
+	}
+
+	var menus []Menu
+	var menuID, sectionID string
+	for _, item := range menu.Flatten(resp, v) {
+		if len(menus) == 0 || item.MenuID != menuID {
+			menus = append(menus, Menu{Type: TypeMenu, Name: item.MenuName, Description: descriptions[item.MenuID]})
+			menuID, sectionID = item.MenuID, ""
+		}
+		m := &menus[len(menus)-1]
+		if len(m.HasMenuSection) == 0 || item.SectionID != sectionID {
+			m.HasMenuSection = append(m.HasMenuSection, MenuSection{Type: TypeMenuSection, Name: item.SectionName})
+			sectionID = item.SectionID
+		}
+		section := &m.HasMenuSection[len(m.HasMenuSection)-1]
+		section.HasMenuItem = append(section.HasMenuItem, menuItem(item))
+	}
+	return menus
+}
+
+// menuItem converts a flattened item with an offer for each of its
+// prices, or its main price if it has no others.
+func menuItem(item menu.Item) MenuItem {
+	li := MenuItem{Type: TypeMenuItem, Name: item.Name, Description: item.Description}
+	prices := item.Prices
+	if len(prices) == 0 && item.Price != nil {
+		prices = []menu.Money{*item.Price}
+	}
+	for _, money := range prices {
+		li.Offers = append(li.Offers, Offer{
+			Type:          TypeOffer,
+			Price:         strconv.FormatFloat(money.Float(), 'f', 2, 64),
+			PriceCurrency: money.Currency,
+		})
+	}
+	return li
+}
+
+// Write writes the restaurant as indented JSON-LD.
+func Write(w io.Writer, r Restaurant) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteScript writes the restaurant in a script tag for the head of a
+// page. The JSON escapes <, > and & so it cannot close the tag early.
+func WriteScript(w io.Writer, r Restaurant) error {
+	if _, err := io.WriteString(w, `<script type="application/ld+json">`+"\n"); err != nil {
+		return err
+	}
+	if err := Write(w, r); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "</script>\n")
+	return err
+}
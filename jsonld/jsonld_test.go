@@ -0,0 +1,140 @@
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func restaurant(t *testing.T) Restaurant {
+	var details struct {
+		Venue foursquarego.Venue `json:"venue"`
+	}
+	var m struct {
+		Menu foursquarego.MenuResp `json:"menu"`
+	}
+	var hours foursquarego.VenueHoursResp
+	foursquaretest.LoadFixture(t, "details", &details)
+	foursquaretest.LoadFixture(t, "menu", &m)
+	foursquaretest.LoadFixture(t, "hours", &hours)
+	return FromVenue(details.Venue, &m.Menu, &hours)
+}
+
+func TestFromVenue(t *testing.T) {
+	r := restaurant(t)
+
+	assert.Equal(t, Context, r.Context)
+	assert.Equal(t, TypeRestaurant, r.Type)
+	assert.Equal(t, "Threes Brewing", r.Name)
+	assert.Equal(t, "https://foursquare.com/v/threes-brewing/5414d0a6498ea3d31a3c64cf", r.ID)
+	assert.Equal(t, "$$", r.PriceRange)
+	assert.Equal(t, &PostalAddress{
+		Type:            TypePostalAddress,
+		StreetAddress:   "333 Douglass St",
+		AddressLocality: "Brooklyn",
+		AddressRegion:   "NY",
+		PostalCode:      "11217",
+		AddressCountry:  "US",
+	}, r.Address)
+	assert.InDelta(t, 40.679799, r.Geo.Latitude, 1e-6)
+	assert.InDelta(t, -73.982159, r.Geo.Longitude, 1e-6)
+	assert.Equal(t, 9.4, r.AggregateRating.RatingValue)
+	assert.Equal(t, float64(10), r.AggregateRating.BestRating)
+
+	assert.Equal(t, []OpeningHoursSpecification{{
+		Type:      TypeOpeningHoursSpecification,
+		DayOfWeek: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+		Opens:     "08:00",
+		Closes:    "02:00",
+	}}, r.OpeningHoursSpecification)
+
+	if assert.NotEmpty(t, r.HasMenu) {
+		main := r.HasMenu[0]
+		assert.Equal(t, "Main Menu", main.Name)
+		assert.Len(t, main.HasMenuSection, 11)
+		soups := main.HasMenuSection[0]
+		assert.Equal(t, "Soups", soups.Name)
+		assert.Equal(t, MenuItem{
+			Type:        TypeMenuItem,
+			Name:        "Westlake Rice Porridge",
+			Description: "Rare beef, crunchy scallop floss, soft egg.",
+			Offers:      []Offer{{Type: TypeOffer, Price: "16.00", PriceCurrency: "USD"}},
+		}, soups.HasMenuItem[0])
+	}
+}
+
+func TestMenus(t *testing.T) {
+	var resp foursquarego.MenuResp
+	err := json.Unmarshal([]byte(`{"menus": {"count": 2, "items": [
+		{"menuId": "m1", "name": "Dinner", "description": "From 5pm", "entries": {"items": [
+			{"sectionId": "s1", "name": "Empty", "entries": {"items": []}},
+			{"sectionId": "s2", "name": "Mains", "entries": {"items": [
+				{"entryId": "e1", "name": "Steak", "prices": ["1.200,50", "1.500,00"]},
+				{"entryId": "e2", "name": "Fish", "price": "Market Price"},
+				{"entryId": "e3", "name": "Pasta", "price": "18"}
+			]}}
+		]}},
+		{"menuId": "m2", "name": "Drinks", "entries": {"items": []}}
+	]}}`), &resp)
+	assert.Nil(t, err)
+
+	v := &foursquarego.Venue{Price: foursquarego.Price{Currency: "kr"}, Location: foursquarego.Location{Cc: "DK"}}
+	assert.Equal(t, []Menu{{
+		Type:        TypeMenu,
+		Name:        "Dinner",
+		Description: "From 5pm",
+		HasMenuSection: []MenuSection{{
+			Type: TypeMenuSection,
+			Name: "Mains",
+			HasMenuItem: []MenuItem{
+				{Type: TypeMenuItem, Name: "Steak", Offers: []Offer{
+					{Type: TypeOffer, Price: "1200.50", PriceCurrency: "DKK"},
+					{Type: TypeOffer, Price: "1500.00", PriceCurrency: "DKK"},
+				}},
+				{Type: TypeMenuItem, Name: "Fish"},
+				{Type: TypeMenuItem, Name: "Pasta", Offers: []Offer{{Type: TypeOffer, Price: "18.00", PriceCurrency: "DKK"}}},
+			},
+		}},
+	}}, Menus(&resp, v))
+}
+
+func TestFromVenue_empty(t *testing.T) {
+	r := FromVenue(foursquarego.Venue{Name: "Nowhere"}, nil, nil)
+	assert.Equal(t, Restaurant{Context: Context, Type: TypeRestaurant, Name: "Nowhere"}, r)
+}
+
+func TestOpeningHours_split(t *testing.T) {
+	h := foursquarego.HoursResp{TimeFrames: []foursquarego.HoursTimeFrame{{
+		Days: []int{6, 7},
+		Open: []foursquarego.HoursOpen{{Start: "1100", End: "1500"}, {Start: "1800", End: "2300"}},
+	}}}
+	specs := OpeningHours(h)
+	if assert.Len(t, specs, 2) {
+		assert.Equal(t, []string{"Saturday", "Sunday"}, specs[0].DayOfWeek)
+		assert.Equal(t, "11:00", specs[0].Opens)
+		assert.Equal(t, "15:00", specs[0].Closes)
+		assert.Equal(t, "18:00", specs[1].Opens)
+		assert.Equal(t, "23:00", specs[1].Closes)
+	}
+}
+
+func TestWriteScript(t *testing.T) {
+	r := Restaurant{Context: Context, Type: TypeRestaurant, Name: "</script><b>"}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteScript(&buf, r))
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, `<script type="application/ld+json">`))
+	assert.True(t, strings.HasSuffix(out, "</script>\n"))
+	assert.Equal(t, 1, strings.Count(out, "</script>"))
+
+	body := strings.TrimSuffix(strings.TrimPrefix(out, `<script type="application/ld+json">`), "</script>\n")
+	var got Restaurant
+	assert.NoError(t, json.Unmarshal([]byte(body), &got))
+	assert.Equal(t, r, got)
+}
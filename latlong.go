@@ -0,0 +1,95 @@
+package foursquarego
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// LatLong simple lat/long fields for SuggestedBounds. It is also used
+// as the Point on parameters, where it is sent as "lat,lng".
+type LatLong struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// ParseLatLong parses a "lat,lng" string such as "40.7,-74".
+func ParseLatLong(s string) (LatLong, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return LatLong{}, fmt.Errorf("foursquare: invalid lat/long %q", s)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return LatLong{}, fmt.Errorf("foursquare: invalid latitude in %q", s)
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return LatLong{}, fmt.Errorf("foursquare: invalid longitude in %q", s)
+	}
+
+	ll := LatLong{Lat: lat, Lng: lng}
+	return ll, ll.Validate()
+}
+
+// Validate checks the latitude is within -90 to 90 and the longitude
+// within -180 to 180.
+func (ll LatLong) Validate() error {
+	if ll.Lat < -90 || ll.Lat > 90 {
+		return fmt.Errorf("foursquare: latitude %v out of range", ll.Lat)
+	}
+	if ll.Lng < -180 || ll.Lng > 180 {
+		return fmt.Errorf("foursquare: longitude %v out of range", ll.Lng)
+	}
+	return nil
+}
+
+// String formats the point as "lat,lng".
+func (ll LatLong) String() string {
+	return strconv.FormatFloat(ll.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(ll.Lng, 'f', -1, 64)
+}
+
+// EncodeValues sets the point as key on the query. It takes precedence
+// over a string value for the same key.
+func (ll LatLong) EncodeValues(key string, v *url.Values) error {
+	if err := ll.Validate(); err != nil {
+		return err
+	}
+	v.Set(key, ll.String())
+	return nil
+}
+
+// Bounds is a box from the south west corner to the north east corner. It
+// is sent as the sw and ne parameters.
+type Bounds struct {
+	Sw LatLong `json:"sw"`
+	Ne LatLong `json:"ne"`
+}
+
+// Validate checks both corners are valid and the south west corner is
+// south of the north east corner.
+func (b Bounds) Validate() error {
+	if err := b.Sw.Validate(); err != nil {
+		return err
+	}
+	if err := b.Ne.Validate(); err != nil {
+		return err
+	}
+	if b.Sw.Lat > b.Ne.Lat {
+		return fmt.Errorf("foursquare: bounds sw %v is north of ne %v", b.Sw, b.Ne)
+	}
+	return nil
+}
+
+// EncodeValues sets sw and ne on the query, key is ignored. They take
+// precedence over string values for sw and ne.
+func (b Bounds) EncodeValues(key string, v *url.Values) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	v.Set("sw", b.Sw.String())
+	v.Set("ne", b.Ne.String())
+	return nil
+}
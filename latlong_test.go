@@ -0,0 +1,65 @@
+package foursquarego
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLatLong(t *testing.T) {
+	ll, err := ParseLatLong("40.7, -74")
+	assert.Nil(t, err)
+	assert.Equal(t, LatLong{Lat: 40.7, Lng: -74}, ll)
+	assert.Equal(t, "40.7,-74", ll.String())
+
+	_, err = ParseLatLong("-94,40.7")
+	assert.Error(t, err)
+	_, err = ParseLatLong("40.7")
+	assert.Error(t, err)
+	_, err = ParseLatLong("north,-74")
+	assert.Error(t, err)
+}
+
+func TestBounds_Validate(t *testing.T) {
+	assert.Nil(t, Bounds{Sw: LatLong{40.7, -74.1}, Ne: LatLong{40.8, -73.9}}.Validate())
+	assert.Error(t, Bounds{Sw: LatLong{40.8, -74.1}, Ne: LatLong{40.7, -73.9}}.Validate())
+	assert.Error(t, Bounds{Sw: LatLong{40.7, -194.1}, Ne: LatLong{40.8, -73.9}}.Validate())
+}
+
+func TestVenueService_SearchPoint(t *testing.T) {
+	const filePath = "./json/venues/search.json"
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
+		assertQueryNoUser(t, map[string]string{
+			"ll":     "40.7,-74",
+			"sw":     "40.7,-74.1",
+			"ne":     "40.8,-73.9",
+			"intent": "browse",
+		}, r)
+
+		b, err := getTestFile(filePath)
+		if err != nil {
+			t.Fatalf("Failed to open testfile %s", filePath)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	_, _, err := client.Venues.Search(&VenueSearchParams{
+		LatLong: "-74,40.7",
+		Point:   &LatLong{Lat: 40.7, Lng: -74},
+		Intent:  IntentBrowse,
+		Bounds:  &Bounds{Sw: LatLong{40.7, -74.1}, Ne: LatLong{40.8, -73.9}},
+	})
+	assert.Nil(t, err)
+
+	_, _, err = client.Venues.Search(&VenueSearchParams{
+		Point: &LatLong{Lat: 100, Lng: -74},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package foursquarego
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// Logger receives a line for every request the Client makes. Arguments are
+// alternating key/value pairs, so a *slog.Logger can be used directly.
+type Logger interface {
+	Info(msg string, args ...interface{})
+	Debug(msg string, args ...interface{})
+}
+
+// SetLogger sets the Logger used for request logging. Set it before making
+// any requests.
+func (c *Client) SetLogger(l Logger) *Client {
+	c.doer.logger = l
+	return c
+}
+
+// SetDebug turns on dumping of raw response bodies to the Logger at
+// debug level.
+func (c *Client) SetDebug(debug bool) *Client {
+	c.doer.debug = debug
+	return c
+}
+
+// doer sits between sling and the http.Client so every request can be
+// logged and sent through the middleware no matter which service made it.
+type doer struct {
+	base       *http.Client
+	client     *http.Client
+	middleware []Middleware
+	logger     Logger
+	debug      bool
+}
+
+func (d *doer) Do(req *http.Request) (*http.Response, error) {
+	if d.logger == nil {
+		return d.client.Do(req)
+	}
+
+	start := time.Now()
+	resp, err := d.client.Do(req)
+	duration := time.Since(start)
+
+	endpoint := Endpoint(req.Context())
+	args := []interface{}{
+		"endpoint", endpoint,
+		"method", req.Method,
+		"path", req.URL.Path,
+		"query", redactQuery(req.URL.Query()).Encode(),
+		"duration", duration,
+	}
+
+	if err != nil {
+		d.logger.Info("foursquare request", append(args, "error", err)...)
+		return resp, err
+	}
+
+	// Photo downloads are images, there is no envelope to read and the
+	// body is not worth buffering or dumping.
+	if endpoint == endpointPhotoMirror {
+		d.logger.Info("foursquare request", append(args, "status", resp.StatusCode)...)
+		return resp, nil
+	}
+
+	meta, body, err := peekMeta(resp)
+	if err != nil {
+		d.logger.Info("foursquare request", append(args, "error", err)...)
+		return resp, err
+	}
+	rate := ParseRate(resp)
+
+	args = append(args,
+		"status", resp.StatusCode,
+		"requestId", meta.RequestID,
+		"rateLimit", rate.Limit,
+		"rateRemaining", rate.Remaining,
+	)
+	d.logger.Info("foursquare request", args...)
+
+	if d.debug {
+		d.logger.Debug("foursquare response body", "requestId", meta.RequestID, "body", string(body))
+	}
+
+	return resp, nil
+}
+
+// redactQuery returns a copy of the query with credentials hidden.
+func redactQuery(q url.Values) url.Values {
+	r := url.Values{}
+	for k, v := range q {
+		r[k] = v
+	}
+	for _, k := range []string{"client_secret", "access_token"} {
+		if r.Get(k) != "" {
+			r.Set(k, redacted)
+		}
+	}
+	return r
+}
@@ -0,0 +1,140 @@
+package match
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Result is the outcome of matching one place in a bulk match.
+type Result struct {
+	Place      Place
+	Candidates []Candidate
+	Err        error
+}
+
+// Best is the highest ranked candidate, false if there are none.
+func (r Result) Best() (Candidate, bool) {
+	if len(r.Candidates) == 0 {
+		return Candidate{}, false
+	}
+	return r.Candidates[0], true
+}
+
+// MatchAll matches the places one at a time. A failed search is recorded
+// in its Result and does not stop the others.
+func (m *Matcher) MatchAll(places []Place) []Result {
+	results := make([]Result, len(places))
+	for i, p := range places {
+		candidates, err := m.Match(p)
+		results[i] = Result{Place: p, Candidates: candidates, Err: err}
+	}
+	return results
+}
+
+// MatchCSV reads places with ReadPlaces, matches them and writes the best
+// match of each with WriteResults.
+func (m *Matcher) MatchCSV(r io.Reader, w io.Writer) error {
+	places, err := ReadPlaces(r)
+	if err != nil {
+		return err
+	}
+	return WriteResults(w, m.MatchAll(places))
+}
+
+// ReadPlaces reads places from CSV with a header row. The columns id,
+// name, address, city, state, postalCode, country, phone, lat and lng are
+// read in any order and other columns are ignored. The name column is
+// required, a row with an empty name is left for Match to reject.
+func ReadPlaces(r io.Reader) ([]Place, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("match: reading header: %v", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("match: no name column")
+	}
+
+	var places []Place
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return places, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(column string) (float64, error) {
+			s := get(column)
+			if s == "" {
+				return 0, nil
+			}
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, fmt.Errorf("match: line %d: %s %q is not a number", line, column, s)
+			}
+			return f, nil
+		}
+
+		p := Place{
+			ID:         get("id"),
+			Name:       get("name"),
+			Address:    get("address"),
+			City:       get("city"),
+			State:      get("state"),
+			PostalCode: get("postalCode"),
+			Country:    get("country"),
+			Phone:      get("phone"),
+		}
+		if p.Lat, err = number("lat"); err != nil {
+			return nil, err
+		}
+		if p.Lng, err = number("lng"); err != nil {
+			return nil, err
+		}
+		places = append(places, p)
+	}
+}
+
+// resultHeader are the columns written by WriteResults.
+var resultHeader = []string{"id", "name", "venueId", "venueName", "venueAddress", "confidence", "distance", "error"}
+
+// WriteResults writes the best candidate of each result as CSV. Places
+// without a candidate have empty venue columns.
+func WriteResults(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	cw.Write(resultHeader)
+	for _, r := range results {
+		row := []string{r.Place.ID, r.Place.Name, "", "", "", "", "", ""}
+		if c, ok := r.Best(); ok {
+			row[2] = c.Venue.ID
+			row[3] = c.Venue.Name
+			row[4] = c.Venue.Location.Address
+			row[5] = strconv.FormatFloat(c.Confidence, 'f', 3, 64)
+			if c.Distance >= 0 {
+				row[6] = strconv.FormatFloat(c.Distance, 'f', 0, 64)
+			}
+		}
+		if r.Err != nil {
+			row[7] = r.Err.Error()
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
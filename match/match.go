@@ -0,0 +1,294 @@
+// Package match finds the foursquare venue for a place from another
+// source, such as a store list, using VenueService.Search with intent
+// match, or intent checkin near the address for places without
+// coordinates.
+//
+//	m := match.NewMatcher(client.Venues)
+//	candidates, err := m.Match(match.Place{Name: "Joe's Pizza", Address: "7 Carmine St", City: "New York"})
+//	if len(candidates) > 0 && candidates[0].Confidence > 0.8 { ... }
+package match
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/geo"
+)
+
+// ErrNoName is returned by Match for a Place without a Name.
+var ErrNoName = errors.New("match: place has no name")
+
+// Place is something to match to a venue. Name is required, along with
+// either Lat and Lng or enough of the address to search near.
+type Place struct {
+	ID         string
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+	Phone      string
+	Lat        float64
+	Lng        float64
+}
+
+func (p Place) hasPoint() bool {
+	return p.Lat != 0 || p.Lng != 0
+}
+
+// near is the place's address for the near parameter.
+func (p Place) near() string {
+	var parts []string
+	for _, s := range []string{p.City, p.State, p.PostalCode, p.Country} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Weights are how much each score counts towards the confidence. Scores
+// that cannot be computed, like phone when either side has none, are left
+// out and the other weights count for more.
+type Weights struct {
+	Name     float64
+	Address  float64
+	Phone    float64
+	Distance float64
+}
+
+// DefaultWeights are the weights used by NewMatcher.
+var DefaultWeights = Weights{Name: 0.45, Address: 0.25, Phone: 0.15, Distance: 0.15}
+
+// DefaultMaxDistance is the distance in meters at which the distance score
+// reaches 0.
+const DefaultMaxDistance = 500
+
+// Candidate is a venue scored against a place. Scores are between 0 and 1,
+// -1 if they could not be computed.
+type Candidate struct {
+	Venue foursquarego.Venue
+	// Confidence is the weighted average of the scores.
+	Confidence    float64
+	NameScore     float64
+	AddressScore  float64
+	PhoneScore    float64
+	DistanceScore float64
+	// Distance is in meters, -1 if either side has no coordinates.
+	Distance float64
+}
+
+// Matcher matches places to venues.
+type Matcher struct {
+	venues foursquarego.VenueAPI
+
+	// Weights of each score in the confidence.
+	Weights Weights
+	// MaxDistance in meters, venues further away get a distance score of 0.
+	MaxDistance float64
+	// Limit is the number of candidates searched for, 0 is the API default.
+	Limit int
+}
+
+// NewMatcher creates a Matcher searching with venues.
+func NewMatcher(venues foursquarego.VenueAPI) *Matcher {
+	return &Matcher{
+		venues:      venues,
+		Weights:     DefaultWeights,
+		MaxDistance: DefaultMaxDistance,
+	}
+}
+
+// Match searches for the place and returns the candidates ranked by
+// confidence, best first. Intent match needs coordinates, a place without
+// them is searched for with intent checkin near its city, state, postal
+// code and country.
+func (m *Matcher) Match(p Place) ([]Candidate, error) {
+	if strings.TrimSpace(p.Name) == "" {
+		return nil, ErrNoName
+	}
+
+	params := &foursquarego.VenueSearchParams{
+		Query: p.Name,
+		Limit: m.Limit,
+	}
+	if p.hasPoint() {
+		params.Intent = foursquarego.IntentMatch
+		params.Point = &foursquarego.LatLong{Lat: p.Lat, Lng: p.Lng}
+		params.Address = p.Address
+		params.City = p.City
+		params.State = p.State
+		params.Zip = p.PostalCode
+		params.Phone = p.Phone
+	} else {
+		params.Intent = foursquarego.IntentCheckin
+		params.Near = p.near()
+	}
+
+	venues, _, err := m.venues.Search(params)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]Candidate, len(venues))
+	for i, v := range venues {
+		candidates[i] = m.Score(p, v)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return candidates, nil
+}
+
+// Score compares a place with a venue.
+func (m *Matcher) Score(p Place, v foursquarego.Venue) Candidate {
+	c := Candidate{
+		Venue:         v,
+		NameScore:     Similarity(NormalizeName(p.Name), NormalizeName(v.Name)),
+		AddressScore:  -1,
+		PhoneScore:    -1,
+		DistanceScore: -1,
+		Distance:      -1,
+	}
+
+	if p.Address != "" && v.Location.Address != "" {
+		c.AddressScore = Similarity(NormalizeAddress(p.Address), NormalizeAddress(v.Location.Address))
+	}
+
+	if a, b := NormalizePhone(p.Phone), NormalizePhone(v.Contact.Phone); a != "" && b != "" {
+		c.PhoneScore = 0
+		if a == b {
+			c.PhoneScore = 1
+		}
+	}
+
+	if p.hasPoint() && (v.Location.Lat != 0 || v.Location.Lng != 0) {
+		c.Distance = geo.VenueDistance(v, foursquarego.LatLong{Lat: p.Lat, Lng: p.Lng})
+		maxDistance := m.MaxDistance
+		if maxDistance <= 0 {
+			maxDistance = DefaultMaxDistance
+		}
+		c.DistanceScore = math.Max(0, 1-c.Distance/maxDistance)
+	}
+
+	var sum, weights float64
+	for _, s := range []struct{ score, weight float64 }{
+		{c.NameScore, m.Weights.Name},
+		{c.AddressScore, m.Weights.Address},
+		{c.PhoneScore, m.Weights.Phone},
+		{c.DistanceScore, m.Weights.Distance},
+	} {
+		if s.score >= 0 {
+			sum += s.score * s.weight
+			weights += s.weight
+		}
+	}
+	if weights > 0 {
+		c.Confidence = sum / weights
+	}
+	return c
+}
+
+// Similarity is the Dice coefficient of the character bigrams of a and b,
+// 1 when they are equal and 0 when they share nothing.
+func Similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ba, bb := bigrams(a), bigrams(b)
+	if len(ba) == 0 || len(bb) == 0 {
+		return 0
+	}
+
+	counts := map[string]int{}
+	for _, g := range ba {
+		counts[g]++
+	}
+	shared := 0
+	for _, g := range bb {
+		if counts[g] > 0 {
+			counts[g]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ba)+len(bb))
+}
+
+func bigrams(s string) []string {
+	r := []rune(strings.Replace(s, " ", "", -1))
+	if len(r) < 2 {
+		return nil
+	}
+	grams := make([]string, len(r)-1)
+	for i := range grams {
+		grams[i] = string(r[i : i+2])
+	}
+	return grams
+}
+
+// words lowercases s, drops punctuation and splits on spaces.
+func words(s string) []string {
+	s = strings.ToLower(strings.Replace(s, "&", " and ", -1))
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+}
+
+// nameStopWords do not help tell venues apart.
+var nameStopWords = map[string]bool{"the": true, "and": true, "a": true}
+
+// NormalizeName lowercases a venue name and drops punctuation,
+// apostrophes and words like "the".
+func NormalizeName(name string) string {
+	var kept []string
+	for _, w := range words(name) {
+		w = strings.Replace(w, "'", "", -1)
+		if w != "" && !nameStopWords[w] {
+			kept = append(kept, w)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// addressAbbreviations are the usual USPS abbreviations.
+var addressAbbreviations = map[string]string{
+	"street": "st", "avenue": "ave", "av": "ave", "road": "rd", "boulevard": "blvd",
+	"drive": "dr", "lane": "ln", "place": "pl", "court": "ct", "square": "sq",
+	"highway": "hwy", "parkway": "pkwy", "terrace": "ter", "plaza": "plz",
+	"north": "n", "south": "s", "east": "e", "west": "w",
+	"northeast": "ne", "northwest": "nw", "southeast": "se", "southwest": "sw",
+	"suite": "ste", "floor": "fl", "first": "1st", "second": "2nd", "third": "3rd",
+}
+
+// NormalizeAddress lowercases a street address, drops punctuation and
+// abbreviates street types and directions so "123 North Main Street" and
+// "123 N. Main St" are the same.
+func NormalizeAddress(address string) string {
+	ws := words(strings.Replace(address, "'", "", -1))
+	for i, w := range ws {
+		if abbr, ok := addressAbbreviations[w]; ok {
+			ws[i] = abbr
+		}
+	}
+	return strings.Join(ws, " ")
+}
+
+// NormalizePhone keeps the last 10 digits of a phone number so country
+// codes and formatting are ignored.
+func NormalizePhone(phone string) string {
+	var digits []rune
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	return string(digits)
+}
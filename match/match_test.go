@@ -0,0 +1,139 @@
+package match
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaremock"
+	"github.com/stretchr/testify/assert"
+)
+
+var joes = foursquarego.Venue{
+	ID:       "joes",
+	Name:     "Joe's Pizza",
+	Contact:  foursquarego.Contact{Phone: "2123661182"},
+	Location: foursquarego.Location{Address: "7 Carmine Street", Lat: 40.7305, Lng: -74.0021},
+}
+
+var johns = foursquarego.Venue{
+	ID:       "johns",
+	Name:     "John's of Bleecker Street",
+	Location: foursquarego.Location{Address: "278 Bleecker St", Lat: 40.7317, Lng: -74.0034},
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "joes pizza", NormalizeName("The Joe's  Pizza!"))
+	assert.Equal(t, NormalizeName("Pizza and Pasta"), NormalizeName("Pizza & Pasta"))
+	assert.Equal(t, "123 n main st ste 4", NormalizeAddress("123 North Main Street, Suite 4"))
+	assert.Equal(t, NormalizeAddress("123 N. Main St."), NormalizeAddress("123 north main street"))
+	assert.Equal(t, "2123661182", NormalizePhone("+1 (212) 366-1182"))
+	assert.Equal(t, "", NormalizePhone("n/a"))
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity("joes pizza", "joes pizza"))
+	assert.Equal(t, 0.0, Similarity("abc", "xyz"))
+	assert.Equal(t, 0.0, Similarity("a", "b"))
+	assert.InDelta(t, 0.8, Similarity("night", "nights"), 0.1)
+}
+
+func TestMatcher_Score(t *testing.T) {
+	m := NewMatcher(nil)
+	place := Place{Name: "Joes Pizza", Address: "7 Carmine St", Phone: "(212) 366-1182", Lat: 40.7305, Lng: -74.0021}
+
+	c := m.Score(place, joes)
+	assert.Equal(t, 1.0, c.NameScore)
+	assert.Equal(t, 1.0, c.AddressScore)
+	assert.Equal(t, 1.0, c.PhoneScore)
+	assert.Equal(t, 1.0, c.DistanceScore)
+	assert.Equal(t, 1.0, c.Confidence)
+
+	c = m.Score(place, johns)
+	assert.Equal(t, -1.0, c.PhoneScore)
+	assert.True(t, c.Distance > 100 && c.Distance < 300, c.Distance)
+	assert.True(t, c.Confidence < 0.6, c.Confidence)
+
+	c = m.Score(Place{Name: "Joe's Pizza"}, joes)
+	assert.Equal(t, -1.0, c.Distance)
+	assert.Equal(t, 1.0, c.Confidence)
+}
+
+func TestMatcher_Match(t *testing.T) {
+	venues := &foursquaremock.VenueAPI{
+		SearchFunc: func(params *foursquarego.VenueSearchParams) ([]foursquarego.Venue, *http.Response, error) {
+			if err := params.Validate(); err != nil {
+				return nil, nil, err
+			}
+			if params.Query == "fail" {
+				return nil, nil, errors.New("server_error")
+			}
+			return []foursquarego.Venue{johns, joes}, nil, nil
+		},
+	}
+	m := NewMatcher(venues)
+
+	candidates, err := m.Match(Place{Name: "Joe's Pizza", Address: "7 Carmine St", City: "New York", State: "NY"})
+	assert.Nil(t, err)
+	if assert.Len(t, candidates, 2) {
+		assert.Equal(t, "joes", candidates[0].Venue.ID)
+		assert.True(t, candidates[0].Confidence > candidates[1].Confidence)
+	}
+
+	calls := venues.Calls("Search")
+	if assert.Len(t, calls, 1) {
+		params := calls[0].Args[0].(*foursquarego.VenueSearchParams)
+		assert.Equal(t, foursquarego.IntentCheckin, params.Intent)
+		assert.Equal(t, "Joe's Pizza", params.Query)
+		assert.Equal(t, "New York, NY", params.Near)
+		assert.Nil(t, params.Point)
+	}
+
+	_, err = m.Match(Place{Name: "Joe's Pizza", Address: "7 Carmine St", City: "New York", State: "NY", PostalCode: "10014", Phone: "212-366-1182", Lat: 40.7305, Lng: -74.0021})
+	assert.Nil(t, err)
+	calls = venues.Calls("Search")
+	if assert.Len(t, calls, 2) {
+		params := calls[1].Args[0].(*foursquarego.VenueSearchParams)
+		assert.Equal(t, foursquarego.IntentMatch, params.Intent)
+		assert.Equal(t, &foursquarego.LatLong{Lat: 40.7305, Lng: -74.0021}, params.Point)
+		assert.Equal(t, "7 Carmine St", params.Address)
+		assert.Equal(t, "New York", params.City)
+		assert.Equal(t, "NY", params.State)
+		assert.Equal(t, "10014", params.Zip)
+		assert.Equal(t, "212-366-1182", params.Phone)
+		assert.Empty(t, params.Near)
+	}
+
+	_, err = m.Match(Place{ID: "s0", Name: " "})
+	assert.Equal(t, ErrNoName, err)
+	assert.Len(t, venues.Calls("Search"), 2)
+
+	input := "id,name,address,phone,lat,lng,notes\n" +
+		"s1,Joe's Pizza,7 Carmine St,212-366-1182,40.7305,-74.0021,x\n" +
+		"s2,fail,,,40.7,-74,\n" +
+		"s3,,,,40.7,-74,\n"
+	var out bytes.Buffer
+	assert.Nil(t, m.MatchCSV(strings.NewReader(input), &out))
+	assert.Equal(t, "id,name,venueId,venueName,venueAddress,confidence,distance,error\n"+
+		"s1,Joe's Pizza,joes,Joe's Pizza,7 Carmine Street,1.000,0,\n"+
+		"s2,fail,,,,,,server_error\n"+
+		"s3,,,,,,,match: place has no name\n", out.String())
+}
+
+func TestReadPlaces(t *testing.T) {
+	places, err := ReadPlaces(strings.NewReader("name,lat,lng,postalCode\nA,1.5,-2,10001\nB,,,\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []Place{
+		{Name: "A", Lat: 1.5, Lng: -2, PostalCode: "10001"},
+		{Name: "B"},
+	}, places)
+
+	_, err = ReadPlaces(strings.NewReader("id,address\n1,x\n"))
+	assert.Error(t, err)
+
+	_, err = ReadPlaces(strings.NewReader("name,lat\nA,north\n"))
+	assert.EqualError(t, err, `match: line 2: lat "north" is not a number`)
+}
@@ -0,0 +1,226 @@
+// Package menu flattens the nested menus from VenueService.Menu into a
+// list of items with parsed prices that can be searched and summarized.
+//
+//	resp, _, err := client.Venues.Menu(venue.ID)
+//	items := menu.Flatten(resp, venue)
+//	for _, item := range menu.Search(items, "noodles") { ... }
+//	stats := menu.Summarize(items)
+package menu
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/peppage/foursquarego"
+)
+
+// Money is a price in hundredths of the currency unit, so 12.50 is 1250.
+type Money struct {
+	Cents    int64  `json:"cents"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// Float is the amount in currency units.
+func (m Money) Float() float64 {
+	return float64(m.Cents) / 100
+}
+
+// String writes the amount with two decimals followed by the currency, ex
+// 12.50 USD.
+func (m Money) String() string {
+	sign := ""
+	cents := m.Cents
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	s := sign + strconv.FormatInt(cents/100, 10) + "." + twoDigits(cents%100)
+	if m.Currency != "" {
+		s += " " + m.Currency
+	}
+	return s
+}
+
+func twoDigits(n int64) string {
+	if n < 10 {
+		return "0" + strconv.FormatInt(n, 10)
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// Item is a menu entry with the names of the menu and section it is in.
+type Item struct {
+	MenuID      string `json:"menuId"`
+	MenuName    string `json:"menuName"`
+	SectionID   string `json:"sectionId"`
+	SectionName string `json:"sectionName"`
+	EntryID     string `json:"entryId"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Price is the main price, nil if the entry has none or it could not
+	// be parsed, such as "Market Price".
+	Price *Money `json:"price,omitempty"`
+	// Prices are all the parsed prices, for sizes and the like.
+	Prices    []Money         `json:"prices,omitempty"`
+	Options   json.RawMessage `json:"options,omitempty"`
+	Additions json.RawMessage `json:"additions,omitempty"`
+}
+
+// Flatten lists every entry of every menu in order. Prices get the
+// currency of the venue, which may be nil.
+func Flatten(resp *foursquarego.MenuResp, venue *foursquarego.Venue) []Item {
+	if resp == nil {
+		return nil
+	}
+	currency := Currency(venue)
+
+	var items []Item
+	for _, m := range resp.Menus.Items {
+		for _, section := range m.Entries.Items {
+			for _, e := range section.Entries.Items {
+				item := Item{
+					MenuID:      m.MenuID,
+					MenuName:    m.Name,
+					SectionID:   section.SectionID,
+					SectionName: section.Name,
+					EntryID:     e.EntryID,
+					Name:        e.Name,
+					Description: e.Description,
+					Options:     e.Options,
+					Additions:   e.Additions,
+				}
+				for _, p := range e.Prices {
+					if money, ok := ParsePrice(p, currency); ok {
+						item.Prices = append(item.Prices, money)
+					}
+				}
+				if money, ok := ParsePrice(e.Price, currency); ok {
+					item.Price = &money
+				} else if len(item.Prices) > 0 {
+					item.Price = &item.Prices[0]
+				}
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// symbols are the ISO 4217 codes of currency symbols used by a single
+// currency.
+var symbols = map[string]string{
+	"€": "EUR", "£": "GBP", "₩": "KRW", "₹": "INR", "₺": "TRY", "₽": "RUB",
+	"R$": "BRL", "₱": "PHP", "฿": "THB", "₫": "VND", "zł": "PLN",
+	"CHF": "CHF", "₪": "ILS", "Rp": "IDR", "RM": "MYR",
+}
+
+// dollars are the currencies written $ by country code.
+var dollars = map[string]string{
+	"US": "USD", "CA": "CAD", "AU": "AUD", "NZ": "NZD", "MX": "MXN", "SG": "SGD",
+	"HK": "HKD", "TW": "TWD", "AR": "ARS", "CL": "CLP", "CO": "COP",
+}
+
+// kronor are the currencies written kr by country code.
+var kronor = map[string]string{
+	"SE": "SEK", "NO": "NOK", "DK": "DKK", "IS": "ISK",
+}
+
+// Currency is the ISO 4217 code for Venue.Price.Currency. Foursquare sends
+// a symbol so $, ¥ and kr are told apart with the venue's country code. It
+// is empty if the venue is nil or the currency is not known, including $
+// and kr in a country missing from the tables.
+func Currency(v *foursquarego.Venue) string {
+	if v == nil {
+		return ""
+	}
+	symbol := strings.TrimSpace(v.Price.Currency)
+	cc := strings.ToUpper(v.Location.Cc)
+
+	switch symbol {
+	case "":
+		return ""
+	case "US$":
+		return "USD"
+	case "$":
+		return dollars[cc]
+	case "kr", "kr.":
+		return kronor[cc]
+	case "¥", "￥":
+		if cc == "CN" {
+			return "CNY"
+		}
+		return "JPY"
+	}
+	if code, ok := symbols[symbol]; ok {
+		return code
+	}
+	if len(symbol) == 3 && strings.ToUpper(symbol) == symbol {
+		return symbol
+	}
+	return ""
+}
+
+// ParsePrice reads a price like "12.00", "$12", "1,200.50", "1.200,50",
+// "1.200.000" or "12,50". It is false for text without a number such as
+// "Market Price".
+func ParsePrice(s, currency string) (Money, bool) {
+	var digits []rune
+	for _, r := range s {
+		if unicode.IsDigit(r) || r == '.' || r == ',' {
+			digits = append(digits, r)
+		} else if len(digits) > 0 {
+			// Only the first number, "12.00 / 15.00" is 12.
+			break
+		}
+	}
+	number := strings.TrimLeft(strings.TrimRight(string(digits), ".,"), ",")
+	if number == "" {
+		return Money{}, false
+	}
+
+	// With both a dot and a comma the last one is the decimal separator,
+	// "1,200.50" and "1.200,50". A comma alone followed by exactly two
+	// digits is a decimal comma. Several dots alone, each followed by three
+	// digits, are thousands separators.
+	dot, comma := strings.LastIndex(number, "."), strings.LastIndex(number, ",")
+	switch {
+	case dot >= 0 && comma > dot:
+		number = strings.Replace(number[:comma], ".", "", -1) + "." + number[comma+1:]
+	case comma < 0 && strings.Count(number, ".") > 1 && thousands(number, "."):
+		number = strings.Replace(number, ".", "", -1)
+	case dot < 0 && comma >= 0 && len(number)-comma-1 == 2:
+		number = number[:comma] + "." + number[comma+1:]
+	}
+	number = strings.Replace(number, ",", "", -1)
+
+	whole, frac := number, ""
+	if i := strings.Index(number, "."); i >= 0 {
+		whole, frac = number[:i], number[i+1:]
+	}
+	if strings.Contains(frac, ".") {
+		return Money{}, false
+	}
+	frac = (frac + "00")[:2]
+	if whole == "" {
+		whole = "0"
+	}
+
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return Money{}, false
+	}
+	return Money{Cents: cents, Currency: currency}, true
+}
+
+// thousands reports whether every group after the first sep in number has
+// three digits, as in "1.200.000".
+func thousands(number, sep string) bool {
+	groups := strings.Split(number, sep)
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,141 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
+	"github.com/stretchr/testify/assert"
+)
+
+func readMenu(t *testing.T, name string) *foursquarego.MenuResp {
+	var resp struct {
+		Menu foursquarego.MenuResp `json:"menu"`
+	}
+	foursquaretest.LoadFixture(t, name, &resp)
+	return &resp.Menu
+}
+
+var venue = &foursquarego.Venue{
+	Price:    foursquarego.Price{Tier: 2, Currency: "$"},
+	Location: foursquarego.Location{Cc: "US"},
+}
+
+func TestParsePrice(t *testing.T) {
+	cases := map[string]int64{
+		"12.00":       1200,
+		"$12":         1200,
+		"12.5":        1250,
+		"1,200.50":    120050,
+		"1.200,50":    120050,
+		"1.234.567,8": 123456780,
+		"1.200.000":   120000000,
+		"12,50":       1250,
+		"€ 8":         800,
+		"12.00/15.00": 1200,
+		".75":         75,
+	}
+	for s, cents := range cases {
+		m, ok := ParsePrice(s, "USD")
+		assert.True(t, ok, s)
+		assert.Equal(t, Money{Cents: cents, Currency: "USD"}, m, s)
+	}
+
+	for _, s := range []string{"", "Market Price", "1.2.3", "1.200.00"} {
+		_, ok := ParsePrice(s, "USD")
+		assert.False(t, ok, s)
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "12.05 USD", Money{Cents: 1205, Currency: "USD"}.String())
+	assert.Equal(t, "-0.50", Money{Cents: -50}.String())
+	assert.Equal(t, 12.5, Money{Cents: 1250}.Float())
+}
+
+func TestCurrency(t *testing.T) {
+	assert.Equal(t, "USD", Currency(venue))
+	assert.Equal(t, "CAD", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "$"}, Location: foursquarego.Location{Cc: "CA"}}))
+	assert.Equal(t, "JPY", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "¥"}, Location: foursquarego.Location{Cc: "JP"}}))
+	assert.Equal(t, "CNY", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "¥"}, Location: foursquarego.Location{Cc: "CN"}}))
+	assert.Equal(t, "", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "$"}, Location: foursquarego.Location{Cc: "ZZ"}}))
+	assert.Equal(t, "USD", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "US$"}, Location: foursquarego.Location{Cc: "ZZ"}}))
+	assert.Equal(t, "EUR", Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "€"}}))
+	for cc, code := range map[string]string{"SE": "SEK", "NO": "NOK", "DK": "DKK", "IS": "ISK", "DE": ""} {
+		assert.Equal(t, code, Currency(&foursquarego.Venue{Price: foursquarego.Price{Currency: "kr"}, Location: foursquarego.Location{Cc: cc}}), cc)
+	}
+	assert.Equal(t, "", Currency(&foursquarego.Venue{}))
+	assert.Equal(t, "", Currency(nil))
+}
+
+func TestFlatten(t *testing.T) {
+	items := Flatten(readMenu(t, "menu"), venue)
+	assert.Len(t, items, 79)
+
+	first := items[0]
+	assert.Equal(t, "Main Menu", first.MenuName)
+	assert.Equal(t, "Soups", first.SectionName)
+	assert.Equal(t, "62463372", first.EntryID)
+	assert.Equal(t, "Westlake Rice Porridge", first.Name)
+	assert.Equal(t, &Money{Cents: 1600, Currency: "USD"}, first.Price)
+
+	assert.Equal(t, "Half Dozen Oysters Or Clams On the Half Shell", items[5].Name)
+	assert.Nil(t, items[5].Price)
+
+	items = Flatten(readMenu(t, "menu_options_synthetic"), nil)
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, []Money{{Cents: 300}, {Cents: 2200}}, items[0].Prices)
+		assert.Equal(t, &Money{Cents: 300}, items[0].Price)
+		assert.NotEmpty(t, items[0].Options)
+	}
+
+	assert.Nil(t, Flatten(nil, venue))
+}
+
+func TestSearch(t *testing.T) {
+	items := Flatten(readMenu(t, "menu"), venue)
+
+	names := func(items []Item) []string {
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{
+		"Chewy Green Tea Noodles",
+		"Squid Ink Peanut Noodles",
+		"Stir Fried Wheat Noodles",
+		"Steamed Oat Noodles",
+		"Spicy Peanut Noodles",
+		"Noodles",
+		"Noodles",
+		"Aromatic Lamb Broth",
+		"Big Tray Fish",
+	}, names(Search(items, "noodle")))
+	assert.Equal(t, []string{"Squid Ink Peanut Noodles", "Spicy Peanut Noodles"}, names(Search(items, "PEANUT noodles")))
+	assert.Equal(t, []string{"Westlake Rice Porridge"}, names(Search(items, "scallop floss")))
+	assert.Empty(t, Search(items, "pizza burger"))
+	assert.Empty(t, Search(items, " "))
+}
+
+func TestSummarize(t *testing.T) {
+	items := Flatten(readMenu(t, "menu"), venue)
+	stats := Summarize(items)
+
+	assert.Equal(t, 79, stats.Items)
+	assert.Equal(t, 52, stats.Priced)
+	assert.Equal(t, Money{Cents: 300, Currency: "USD"}, stats.Min)
+	assert.Equal(t, Money{Cents: 15000, Currency: "USD"}, stats.Max)
+	assert.Equal(t, Money{Cents: 1400, Currency: "USD"}, stats.Median)
+	assert.Equal(t, Money{Cents: 2482, Currency: "USD"}, stats.Mean)
+
+	soups := stats.Sections["Soups"]
+	assert.Equal(t, 5, soups.Priced)
+	assert.Equal(t, Money{Cents: 1540, Currency: "USD"}, soups.Mean)
+	assert.Equal(t, Money{Cents: 1600, Currency: "USD"}, soups.Median)
+	assert.Equal(t, 0, stats.Sections["Dinner - a"].Priced)
+
+	assert.Equal(t, Stats{Sections: map[string]Stats{}}, Summarize(nil))
+}
@@ -0,0 +1,68 @@
+package menu
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Search returns the items whose name, description or section contain
+// every word of the query, ignoring case. Words match the start of a word
+// so "noodle" finds "Noodles". Items matching in the name come first, then
+// the menu order is kept.
+func Search(items []Item, query string) []Item {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	type hit struct {
+		item  Item
+		score int
+	}
+	var hits []hit
+	for _, item := range items {
+		name := tokenize(item.Name)
+		rest := append(tokenize(item.Description), tokenize(item.SectionName)...)
+
+		score := 0
+		for _, term := range terms {
+			switch {
+			case hasPrefix(name, term):
+				score += 2
+			case hasPrefix(rest, term):
+				score++
+			default:
+				score = -1
+			}
+			if score < 0 {
+				break
+			}
+		}
+		if score > 0 {
+			hits = append(hits, hit{item, score})
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].score > hits[j].score })
+	found := make([]Item, len(hits))
+	for i, h := range hits {
+		found[i] = h.item
+	}
+	return found
+}
+
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func hasPrefix(words []string, prefix string) bool {
+	for _, w := range words {
+		if strings.HasPrefix(w, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package menu
+
+import (
+	"math"
+	"sort"
+)
+
+// Stats are price statistics of a venue's menu items.
+type Stats struct {
+	// Items is the number of items, Priced the number with a price.
+	Items  int `json:"items"`
+	Priced int `json:"priced"`
+	// Min, Max, Mean and Median of the main prices, zero if no item has
+	// one.
+	Min    Money `json:"min"`
+	Max    Money `json:"max"`
+	Mean   Money `json:"mean"`
+	Median Money `json:"median"`
+	// Sections has the stats of each section by name.
+	Sections map[string]Stats `json:"sections,omitempty"`
+}
+
+// Summarize computes the price statistics of the items and of each of
+// their sections.
+func Summarize(items []Item) Stats {
+	stats := summarize(items)
+
+	bySection := map[string][]Item{}
+	for _, item := range items {
+		bySection[item.SectionName] = append(bySection[item.SectionName], item)
+	}
+	stats.Sections = make(map[string]Stats, len(bySection))
+	for name, sectionItems := range bySection {
+		stats.Sections[name] = summarize(sectionItems)
+	}
+	return stats
+}
+
+func summarize(items []Item) Stats {
+	stats := Stats{Items: len(items)}
+
+	var prices []Money
+	for _, item := range items {
+		if item.Price != nil {
+			prices = append(prices, *item.Price)
+		}
+	}
+	stats.Priced = len(prices)
+	if len(prices) == 0 {
+		return stats
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cents < prices[j].Cents })
+	currency := prices[0].Currency
+	var sum int64
+	for _, p := range prices {
+		sum += p.Cents
+	}
+
+	n := len(prices)
+	median := prices[n/2].Cents
+	if n%2 == 0 {
+		median = roundDiv(prices[n/2-1].Cents+prices[n/2].Cents, 2)
+	}
+
+	stats.Min = prices[0]
+	stats.Max = prices[n-1]
+	stats.Mean = Money{Cents: roundDiv(sum, int64(n)), Currency: currency}
+	stats.Median = Money{Cents: median, Currency: currency}
+	return stats
+}
+
+// roundDiv divides rounding half away from zero.
+func roundDiv(a, b int64) int64 {
+	return int64(math.Round(float64(a) / float64(b)))
+}
@@ -0,0 +1,165 @@
+package foursquarego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Middleware wraps the http.RoundTripper used by the Client. The request
+// context carries the logical endpoint name, see Endpoint.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is a function that can be used as an http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use adds middleware to the Client. The first middleware added is the
+// outermost one. Add middleware before making any requests.
+func (c *Client) Use(mw ...Middleware) *Client {
+	c.doer.middleware = append(c.doer.middleware, mw...)
+
+	transport := c.doer.base.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	for i := len(c.doer.middleware) - 1; i >= 0; i-- {
+		transport = c.doer.middleware[i](transport)
+	}
+
+	client := *c.doer.base
+	client.Transport = transport
+	c.doer.client = &client
+	return c
+}
+
+type endpointKey struct{}
+
+func withEndpoint(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, endpointKey{}, endpoint)
+}
+
+// Endpoint returns the logical endpoint name of a request such as
+// venues.details or venues.search. Unlike the path it does not contain
+// venue IDs so it is safe to use as a metric label.
+func Endpoint(ctx context.Context) string {
+	endpoint, _ := ctx.Value(endpointKey{}).(string)
+	return endpoint
+}
+
+// Tracer starts a Span for every request, OpenTelemetry tracers can be
+// adapted to it.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced request.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// Metrics records counters and latencies for every request labeled with
+// the logical endpoint name.
+type Metrics interface {
+	ObserveLatency(ctx context.Context, endpoint string, d time.Duration)
+	IncError(ctx context.Context, endpoint, errorType string)
+	SetQuota(ctx context.Context, endpoint string, limit, remaining int)
+}
+
+// Error type reported to Metrics when the request did not get a complete
+// response.
+const errorTypeTransport = "transport"
+
+// Instrument returns a Middleware reporting spans to tracer and counters
+// to metrics. Either may be nil.
+func Instrument(tracer Tracer, metrics Metrics) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			endpoint := Endpoint(ctx)
+
+			var span Span
+			if tracer != nil {
+				ctx, span = tracer.Start(ctx, endpoint)
+				req = req.WithContext(ctx)
+				span.SetAttribute("http.method", req.Method)
+				defer span.End()
+			}
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			duration := time.Since(start)
+
+			if metrics != nil {
+				metrics.ObserveLatency(ctx, endpoint, duration)
+			}
+
+			if err != nil {
+				if span != nil {
+					span.RecordError(err)
+				}
+				if metrics != nil {
+					metrics.IncError(ctx, endpoint, errorTypeTransport)
+				}
+				return resp, err
+			}
+
+			meta, _, readErr := peekMeta(resp)
+			if readErr != nil {
+				if span != nil {
+					span.RecordError(readErr)
+				}
+				if metrics != nil {
+					metrics.IncError(ctx, endpoint, errorTypeTransport)
+				}
+				return resp, readErr
+			}
+			rate := ParseRate(resp)
+
+			if span != nil {
+				span.SetAttribute("http.status_code", resp.StatusCode)
+				span.SetAttribute("foursquare.request_id", meta.RequestID)
+				if meta.ErrorType != "" {
+					span.RecordError(&APIError{Meta: meta})
+				}
+			}
+
+			if metrics != nil {
+				if meta.ErrorType != "" {
+					metrics.IncError(ctx, endpoint, meta.ErrorType)
+				}
+				if resp.Header.Get(headerRateLimit) != "" {
+					metrics.SetQuota(ctx, endpoint, rate.Limit, rate.Remaining)
+				}
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+// peekMeta reads the Meta out of a response and puts the body back so it
+// can still be decoded.
+func peekMeta(resp *http.Response) (Meta, []byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return Meta{}, nil, err
+	}
+
+	meta := new(struct {
+		Meta Meta `json:"meta"`
+	})
+	json.Unmarshal(body, meta)
+	return meta.Meta, body, nil
+}
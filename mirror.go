@@ -0,0 +1,246 @@
+package foursquarego
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	manifestFile       = "manifest.json"
+	partialDir         = ".partial"
+	defaultConcurrency = 4
+
+	// endpointPhotoMirror labels photo downloads for the logger and
+	// middleware.
+	endpointPhotoMirror = "photos.mirror"
+)
+
+// MirrorOptions are the options for Client.MirrorPhotos
+type MirrorOptions struct {
+	// Sizes to download for every photo, defaults to PhotoOriginal.
+	Sizes []PhotoSize
+	// Concurrency is the number of downloads at once, defaults to 4.
+	Concurrency int
+}
+
+// PhotoManifest maps a Photo.ID to the downloaded files of that photo keyed
+// by PhotoSize.String(). Paths are relative to the mirror directory.
+type PhotoManifest map[string]map[string]string
+
+// MirrorPhotos downloads the photos into dir using the Client's http.Client,
+// logger and middleware.
+// Use it with the Items of a PhotoGrouping or a Venue's BestPhoto.
+//
+// Files are named by the sha256 of their content so the same image is only
+// stored once. The manifest is saved in dir, photos already in it are
+// skipped and unfinished downloads are resumed. Photos with an ID that is
+// not safe as a file name are not downloaded. All downloads are tried,
+// the first error is returned along with the manifest of what succeeded.
+func (c *Client) MirrorPhotos(dir string, opts *MirrorOptions, photos ...Photo) (PhotoManifest, error) {
+	if opts == nil {
+		opts = new(MirrorOptions)
+	}
+	sizes := opts.Sizes
+	if len(sizes) == 0 {
+		sizes = []PhotoSize{PhotoOriginal}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, partialDir), 0755); err != nil {
+		return nil, err
+	}
+	manifest, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	// The same photo is often passed twice, as a BestPhoto and in a group,
+	// and two downloads into one partial file would corrupt it.
+	seen := map[string]bool{}
+	for _, photo := range photos {
+		if photo.ID != "" && !validPhotoID(photo.ID) {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = fmt.Errorf("foursquare: invalid photo id %q", photo.ID)
+			}
+			mu.Unlock()
+			continue
+		}
+		for _, size := range sizes {
+			if photo.ID == "" || photo.URL(size) == "" {
+				continue
+			}
+			key := photo.ID + "_" + size.String()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if path, ok := manifest[photo.ID][size.String()]; ok {
+				if _, err := os.Stat(filepath.Join(dir, path)); err == nil {
+					continue
+				}
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(photo Photo, size PhotoSize) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				path, err := c.mirrorPhoto(dir, photo, size)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+					return
+				}
+				if manifest[photo.ID] == nil {
+					manifest[photo.ID] = map[string]string{}
+				}
+				manifest[photo.ID][size.String()] = path
+			}(photo, size)
+		}
+	}
+	wg.Wait()
+
+	if err := writeManifest(dir, manifest); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	return manifest, firstErr
+}
+
+// mirrorPhoto downloads a single size of a photo, resuming a partial file
+// if there is one, and moves it to its content address.
+func (c *Client) mirrorPhoto(dir string, photo Photo, size PhotoSize) (string, error) {
+	partial := filepath.Join(dir, partialDir, photo.ID+"_"+size.String())
+
+	f, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("GET", photo.URL(size), nil)
+	if err != nil {
+		return "", err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+	req = req.WithContext(withEndpoint(req.Context(), endpointPhotoMirror))
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The server sent everything, start over.
+		if err := f.Truncate(0); err != nil {
+			return "", err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file is already complete.
+	default:
+		return "", fmt.Errorf("foursquare: %d downloading photo %s", resp.StatusCode, photo.ID)
+	}
+
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		if _, err := io.Copy(f, resp.Body); err != nil {
+			return "", err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	sum, err := hashFile(partial)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(sum[:2], sum+filepath.Ext(photo.Suffix))
+	if err := os.MkdirAll(filepath.Join(dir, sum[:2]), 0755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(partial, filepath.Join(dir, path)); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// validPhotoID reports whether id is safe to use in a file name.
+func validPhotoID(id string) bool {
+	return !strings.ContainsAny(id, `/\`) && !strings.Contains(id, "..")
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func readManifest(dir string) (PhotoManifest, error) {
+	manifest := PhotoManifest{}
+	b, err := ioutil.ReadFile(filepath.Join(dir, manifestFile))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return manifest, json.Unmarshal(b, &manifest)
+}
+
+func writeManifest(dir string, manifest PhotoManifest) error {
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Replace the manifest through a temporary file so an interrupted run
+	// never leaves half of it.
+	tmp := filepath.Join(dir, "."+manifestFile+".tmp")
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, manifestFile))
+}
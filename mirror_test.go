@@ -0,0 +1,146 @@
+package foursquarego
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_MirrorPhotos(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	images := map[string][]byte{
+		"/img/general/original/1.jpg": []byte("original image one"),
+		"/img/general/cap300/1.jpg":   []byte("small image one"),
+		"/img/general/original/2.jpg": []byte("original image one"),
+		"/img/general/cap300/2.jpg":   []byte("small image two"),
+	}
+
+	var mu sync.Mutex
+	var requests []string
+	mux.HandleFunc("/img/general/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path+" "+r.Header.Get("Range"))
+		mu.Unlock()
+
+		b, ok := images[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(b))
+	})
+
+	dir, err := ioutil.TempDir("", "mirror")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// A download of the second cap300 photo was interrupted.
+	os.MkdirAll(filepath.Join(dir, partialDir), 0755)
+	ioutil.WriteFile(filepath.Join(dir, partialDir, "2_cap300"), []byte("small "), 0644)
+
+	photos := []Photo{
+		{ID: "1", Prefix: "https://igx.4sqi.net/img/general/", Suffix: "/1.jpg"},
+		{ID: "2", Prefix: "https://igx.4sqi.net/img/general/", Suffix: "/2.jpg"},
+	}
+	opts := &MirrorOptions{Sizes: []PhotoSize{PhotoOriginal, PhotoCap(300)}}
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	manifest, err := client.MirrorPhotos(dir, opts, photos...)
+	assert.Nil(t, err)
+
+	assert.Len(t, requests, 4)
+	assert.Contains(t, requests, "/img/general/cap300/2.jpg bytes=6-")
+
+	assert.Len(t, manifest, 2)
+	assert.Equal(t, manifest["1"]["original"], manifest["2"]["original"])
+	assert.Equal(t, ".jpg", filepath.Ext(manifest["1"]["original"]))
+	for path, want := range map[string]string{
+		manifest["1"]["original"]: "original image one",
+		manifest["1"]["cap300"]:   "small image one",
+		manifest["2"]["cap300"]:   "small image two",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, path))
+		assert.Nil(t, err)
+		assert.Equal(t, want, string(b))
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "."+manifestFile+".tmp"))
+	assert.True(t, os.IsNotExist(err))
+
+	requests = nil
+	again, err := client.MirrorPhotos(dir, opts, photos...)
+	assert.Nil(t, err)
+	assert.Len(t, requests, 0)
+	assert.Equal(t, manifest, again)
+
+	_, err = client.MirrorPhotos(dir, nil, Photo{ID: "3", Prefix: "https://igx.4sqi.net/img/general/", Suffix: "/3.jpg"})
+	assert.Error(t, err)
+}
+
+func TestClient_MirrorPhotos_duplicates(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var mu sync.Mutex
+	count := 0
+	mux.HandleFunc("/img/general/original/1.jpg", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader([]byte("original image one")))
+	})
+
+	dir, err := ioutil.TempDir("", "mirror")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var endpoints []string
+	logger := new(testLogger)
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "").
+		SetLogger(logger).
+		SetDebug(true).
+		Use(func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				endpoints = append(endpoints, Endpoint(req.Context()))
+				mu.Unlock()
+				return next.RoundTrip(req)
+			})
+		})
+
+	best := Photo{ID: "1", Prefix: "https://igx.4sqi.net/img/general/", Suffix: "/1.jpg"}
+	manifest, err := client.MirrorPhotos(dir, nil, best, best, best)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"photos.mirror"}, endpoints)
+	if assert.Len(t, logger.info, 1) {
+		assert.Equal(t, http.StatusOK, logValue(logger.info[0], "status"))
+	}
+	assert.Empty(t, logger.debug)
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, manifest["1"]["original"]))
+	assert.Nil(t, err)
+	assert.Equal(t, "original image one", string(b))
+}
+
+func TestClient_MirrorPhotos_invalidID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mirror")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	client := NewClient(nil, "foursquare", clientID, clientSecret, "")
+	for _, id := range []string{"../../etc/passwd", "a/b", `a\b`, ".."} {
+		manifest, err := client.MirrorPhotos(dir, nil, Photo{ID: id, Prefix: "https://igx.4sqi.net/img/general/", Suffix: "/1.jpg"})
+		assert.Error(t, err, id)
+		assert.Len(t, manifest, 0)
+	}
+}
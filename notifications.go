@@ -0,0 +1,160 @@
+package foursquarego
+
+import "encoding/json"
+
+// Notification comes with all responses. Item is decoded based on Type,
+// unknown types and items that do not fit their type are kept as a
+// *RawNotification.
+// https://developer.foursquare.com/docs/responses/notifications
+type Notification struct {
+	Type string           `json:"type"`
+	Item NotificationItem `json:"item"`
+}
+
+// Options for Notification.Type
+const (
+	NotificationTypeTray        = "notificationTray"
+	NotificationTypeMessage     = "message"
+	NotificationTypeScore       = "score"
+	NotificationTypeMayorship   = "mayorship"
+	NotificationTypeLeaderboard = "leaderboard"
+	NotificationTypeTipAlert    = "tipAlert"
+)
+
+// NotificationItem is the Item in a Notification.
+type NotificationItem interface {
+	NotificationType() string
+}
+
+// NotificationTray is the count of unread notifications for the acting user.
+type NotificationTray struct {
+	UnreadCount int `json:"unreadCount"`
+}
+
+// NotificationType is notificationTray.
+func (n *NotificationTray) NotificationType() string { return NotificationTypeTray }
+
+// MessageNotification is a message to show to the user.
+type MessageNotification struct {
+	Message string `json:"message"`
+}
+
+// NotificationType is message.
+func (n *MessageNotification) NotificationType() string { return NotificationTypeMessage }
+
+// ScoreNotification contains the points earned by a checkin.
+type ScoreNotification struct {
+	Scores []Score `json:"scores"`
+	Total  int     `json:"total"`
+}
+
+// NotificationType is score.
+func (n *ScoreNotification) NotificationType() string { return NotificationTypeScore }
+
+// Score is a single reason for points in a ScoreNotification.
+type Score struct {
+	Points  int    `json:"points"`
+	Icon    string `json:"icon"`
+	Message string `json:"message"`
+}
+
+// MayorshipNotification tells the user about the mayorship of the venue.
+type MayorshipNotification struct {
+	Type       string `json:"type"`
+	Checkins   int    `json:"checkins"`
+	DaysBehind int    `json:"daysBehind"`
+	User       User   `json:"user"`
+	Message    string `json:"message"`
+	Image      string `json:"image"`
+}
+
+// NotificationType is mayorship.
+func (n *MayorshipNotification) NotificationType() string { return NotificationTypeMayorship }
+
+// LeaderboardNotification is the user's position on the leaderboard.
+type LeaderboardNotification struct {
+	Leaderboard []LeaderboardItem `json:"leaderboard"`
+	Message     string            `json:"message"`
+	Scores      []Score           `json:"scores"`
+	Total       int               `json:"total"`
+}
+
+// NotificationType is leaderboard.
+func (n *LeaderboardNotification) NotificationType() string { return NotificationTypeLeaderboard }
+
+// LeaderboardItem is a user on the leaderboard.
+type LeaderboardItem struct {
+	User   User              `json:"user"`
+	Scores LeaderboardScores `json:"scores"`
+	Rank   int               `json:"rank"`
+}
+
+// LeaderboardScores are the scores of a LeaderboardItem.
+type LeaderboardScores struct {
+	Recent        int `json:"recent"`
+	Max           int `json:"max"`
+	CheckinsCount int `json:"checkinsCount"`
+}
+
+// TipAlertNotification is a tip to show to the user at the venue.
+type TipAlertNotification struct {
+	Tip Tip `json:"tip"`
+}
+
+// NotificationType is tipAlert.
+func (n *TipAlertNotification) NotificationType() string { return NotificationTypeTipAlert }
+
+// RawNotification is a notification of a type without a known datastructure.
+// Item is the json exactly as it was sent.
+type RawNotification struct {
+	Type string
+	Item json.RawMessage
+}
+
+// NotificationType is the type the notification was sent with.
+func (n *RawNotification) NotificationType() string { return n.Type }
+
+// MarshalJSON returns the item as it was sent.
+func (n *RawNotification) MarshalJSON() ([]byte, error) {
+	if n.Item == nil {
+		return []byte("null"), nil
+	}
+	return n.Item, nil
+}
+
+// UnmarshalJSON decodes the Item into the type matching the notification Type.
+func (n *Notification) UnmarshalJSON(b []byte) error {
+	raw := new(struct {
+		Type string          `json:"type"`
+		Item json.RawMessage `json:"item"`
+	})
+	if err := json.Unmarshal(b, raw); err != nil {
+		return err
+	}
+
+	var item NotificationItem
+	switch raw.Type {
+	case NotificationTypeTray:
+		item = new(NotificationTray)
+	case NotificationTypeMessage:
+		item = new(MessageNotification)
+	case NotificationTypeScore:
+		item = new(ScoreNotification)
+	case NotificationTypeMayorship:
+		item = new(MayorshipNotification)
+	case NotificationTypeLeaderboard:
+		item = new(LeaderboardNotification)
+	case NotificationTypeTipAlert:
+		item = new(TipAlertNotification)
+	}
+
+	// An item that does not match its type is kept raw rather than failing
+	// the decoding of the whole response.
+	if item == nil || len(raw.Item) == 0 || json.Unmarshal(raw.Item, item) != nil {
+		item = &RawNotification{Type: raw.Type, Item: raw.Item}
+	}
+
+	n.Type = raw.Type
+	n.Item = item
+	return nil
+}
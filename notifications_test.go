@@ -0,0 +1,62 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification_UnmarshalJSON(t *testing.T) {
+	b := []byte(`[
+		{"type": "notificationTray", "item": {"unreadCount": 5}},
+		{"type": "message", "item": {"message": "Welcome back!"}},
+		{"type": "score", "item": {"scores": [{"points": 1, "icon": "https://ss1.4sqi.net/img/points/coin.png", "message": "First stop today"}], "total": 1}},
+		{"type": "surprise", "item": {"anything": [1, 2]}}
+	]`)
+
+	var notifications []Notification
+	err := json.Unmarshal(b, &notifications)
+	assert.Nil(t, err)
+	assert.Len(t, notifications, 4)
+
+	tray, ok := notifications[0].Item.(*NotificationTray)
+	assert.True(t, ok)
+	assert.Equal(t, 5, tray.UnreadCount)
+
+	message, ok := notifications[1].Item.(*MessageNotification)
+	assert.True(t, ok)
+	assert.Equal(t, "Welcome back!", message.Message)
+
+	score, ok := notifications[2].Item.(*ScoreNotification)
+	assert.True(t, ok)
+	assert.Equal(t, 1, score.Total)
+	assert.Equal(t, "First stop today", score.Scores[0].Message)
+
+	raw, ok := notifications[3].Item.(*RawNotification)
+	assert.True(t, ok)
+	assert.Equal(t, "surprise", raw.NotificationType())
+	assert.JSONEq(t, `{"anything": [1, 2]}`, string(raw.Item))
+
+	out, err := json.Marshal(notifications[3])
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"type": "surprise", "item": {"anything": [1, 2]}}`, string(out))
+}
+
+func TestNotification_UnmarshalJSON_malformed(t *testing.T) {
+	b := []byte(`{
+		"meta": {"code": 200},
+		"notifications": [{"type": "score", "item": {"scores": "none", "total": "1"}}],
+		"response": {"venue": {"id": "5414d0a6498ea3d31a3c64cf"}}
+	}`)
+
+	var response Response
+	err := json.Unmarshal(b, &response)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"venue": {"id": "5414d0a6498ea3d31a3c64cf"}}`, string(response.Response))
+
+	raw, ok := response.Notifications[0].Item.(*RawNotification)
+	assert.True(t, ok)
+	assert.Equal(t, NotificationTypeScore, raw.NotificationType())
+	assert.JSONEq(t, `{"scores": "none", "total": "1"}`, string(raw.Item))
+}
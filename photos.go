@@ -0,0 +1,161 @@
+package foursquarego
+
+import "strconv"
+
+type photoSizeKind int
+
+const (
+	sizeOriginal photoSizeKind = iota
+	sizeExact
+	sizeCap
+	sizeWidth
+	sizeHeight
+)
+
+// PhotoSize is a size option for Photo.URL. Create one with PhotoOriginal,
+// PhotoExact, PhotoCap, PhotoWidth or PhotoHeight.
+// https://developer.foursquare.com/docs/api/photos/details
+type PhotoSize struct {
+	kind   photoSizeKind
+	width  int
+	height int
+}
+
+// PhotoOriginal is the photo as it was uploaded.
+var PhotoOriginal = PhotoSize{kind: sizeOriginal}
+
+// PhotoExact is a photo cropped to exactly width x height.
+func PhotoExact(width, height int) PhotoSize {
+	return PhotoSize{kind: sizeExact, width: width, height: height}
+}
+
+// PhotoCap is a photo scaled so neither side is longer than n.
+func PhotoCap(n int) PhotoSize {
+	return PhotoSize{kind: sizeCap, width: n, height: n}
+}
+
+// PhotoWidth is a photo scaled to be n wide.
+func PhotoWidth(n int) PhotoSize {
+	return PhotoSize{kind: sizeWidth, width: n}
+}
+
+// PhotoHeight is a photo scaled to be n high.
+func PhotoHeight(n int) PhotoSize {
+	return PhotoSize{kind: sizeHeight, height: n}
+}
+
+// String is the size as it is put in the URL, ex 300x300 or cap300.
+func (s PhotoSize) String() string {
+	switch s.kind {
+	case sizeExact:
+		return strconv.Itoa(s.width) + "x" + strconv.Itoa(s.height)
+	case sizeCap:
+		return "cap" + strconv.Itoa(s.width)
+	case sizeWidth:
+		return "width" + strconv.Itoa(s.width)
+	case sizeHeight:
+		return "height" + strconv.Itoa(s.height)
+	}
+	return "original"
+}
+
+// URL builds the URL of the photo at the size.
+func (p Photo) URL(size PhotoSize) string {
+	if p.Prefix == "" {
+		return ""
+	}
+	return p.Prefix + size.String() + p.Suffix
+}
+
+// Fit is the width and height the photo will have at the size. The aspect
+// ratio is kept unless the size is PhotoExact. If Width or Height of the
+// photo is unknown only the requested dimensions are returned.
+func (p Photo) Fit(size PhotoSize) (width, height int) {
+	switch size.kind {
+	case sizeExact:
+		return size.width, size.height
+	case sizeOriginal:
+		return p.Width, p.Height
+	}
+
+	if p.Width <= 0 || p.Height <= 0 {
+		return size.width, size.height
+	}
+
+	switch size.kind {
+	case sizeWidth:
+		return size.width, scale(p.Height, size.width, p.Width)
+	case sizeHeight:
+		return scale(p.Width, size.height, p.Height), size.height
+	}
+
+	// sizeCap never makes a photo bigger.
+	if p.Width <= size.width && p.Height <= size.width {
+		return p.Width, p.Height
+	}
+	if p.Width >= p.Height {
+		return size.width, scale(p.Height, size.width, p.Width)
+	}
+	return scale(p.Width, size.width, p.Height), size.width
+}
+
+// SizeToFit returns the largest size that keeps the aspect ratio and fits
+// in a maxWidth x maxHeight box.
+func (p Photo) SizeToFit(maxWidth, maxHeight int) PhotoSize {
+	if p.Width <= 0 || p.Height <= 0 {
+		return PhotoCap(minInt(maxWidth, maxHeight))
+	}
+	if p.Width*maxHeight >= p.Height*maxWidth {
+		return PhotoWidth(maxWidth)
+	}
+	return PhotoHeight(maxHeight)
+}
+
+// PhotoURL builds the URL of the user's photo at the size. Empty if the
+// user has no photo.
+func (u User) PhotoURL(size PhotoSize) string {
+	if u.Photo == nil {
+		return ""
+	}
+	return u.Photo.URL(size)
+}
+
+// IconSize is a size option for Icon.URL. Category icons are square.
+// https://developer.foursquare.com/docs/api/venues/categories
+type IconSize int
+
+// Options for IconSize
+const (
+	IconSize32 IconSize = 32
+	IconSize44 IconSize = 44
+	IconSize64 IconSize = 64
+	IconSize88 IconSize = 88
+)
+
+// URL builds the URL of the icon at the size.
+func (i Icon) URL(size IconSize) string {
+	if i.Prefix == "" {
+		return ""
+	}
+	return i.Prefix + strconv.Itoa(int(size)) + i.Suffix
+}
+
+// BackgroundURL builds the URL of the icon with a gray background.
+func (i Icon) BackgroundURL(size IconSize) string {
+	if i.Prefix == "" {
+		return ""
+	}
+	return i.Prefix + "bg_" + strconv.Itoa(int(size)) + i.Suffix
+}
+
+// scale returns v * num / den rounded to the nearest int.
+func scale(v, num, den int) int {
+	return (v*num + den/2) / den
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+package foursquarego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoto_URL(t *testing.T) {
+	photo := Photo{
+		Prefix: "https://igx.4sqi.net/img/general/",
+		Suffix: "/12345_abc.jpg",
+		Width:  1440,
+		Height: 1920,
+	}
+
+	assert.Equal(t, "https://igx.4sqi.net/img/general/original/12345_abc.jpg", photo.URL(PhotoOriginal))
+	assert.Equal(t, "https://igx.4sqi.net/img/general/300x300/12345_abc.jpg", photo.URL(PhotoExact(300, 300)))
+	assert.Equal(t, "https://igx.4sqi.net/img/general/cap300/12345_abc.jpg", photo.URL(PhotoCap(300)))
+	assert.Equal(t, "https://igx.4sqi.net/img/general/width500/12345_abc.jpg", photo.URL(PhotoWidth(500)))
+	assert.Equal(t, "https://igx.4sqi.net/img/general/height500/12345_abc.jpg", photo.URL(PhotoHeight(500)))
+	assert.Equal(t, "", Photo{}.URL(PhotoOriginal))
+}
+
+func TestPhoto_Fit(t *testing.T) {
+	photo := Photo{Width: 1440, Height: 1920}
+
+	tests := []struct {
+		size   PhotoSize
+		width  int
+		height int
+	}{
+		{PhotoOriginal, 1440, 1920},
+		{PhotoExact(300, 300), 300, 300},
+		{PhotoCap(300), 225, 300},
+		{PhotoCap(5000), 1440, 1920},
+		{PhotoWidth(500), 500, 667},
+		{PhotoHeight(500), 375, 500},
+	}
+	for _, test := range tests {
+		w, h := photo.Fit(test.size)
+		assert.Equal(t, test.width, w, test.size.String())
+		assert.Equal(t, test.height, h, test.size.String())
+	}
+
+	assert.Equal(t, PhotoHeight(300), photo.SizeToFit(300, 300))
+	assert.Equal(t, PhotoWidth(300), Photo{Width: 1920, Height: 1440}.SizeToFit(300, 300))
+}
+
+func TestIcon_URL(t *testing.T) {
+	icon := Icon{
+		Prefix: "https://ss3.4sqi.net/img/categories_v2/food/brewery_",
+		Suffix: ".png",
+	}
+
+	assert.Equal(t, "https://ss3.4sqi.net/img/categories_v2/food/brewery_88.png", icon.URL(IconSize88))
+	assert.Equal(t, "https://ss3.4sqi.net/img/categories_v2/food/brewery_bg_32.png", icon.BackgroundURL(IconSize32))
+}
+
+func TestUser_PhotoURL(t *testing.T) {
+	user := User{Photo: &Photo{Prefix: "https://igx.4sqi.net/img/user/", Suffix: "/68150-NB43B0NAABATDOBQ"}}
+
+	assert.Equal(t, "https://igx.4sqi.net/img/user/100x100/68150-NB43B0NAABATDOBQ", user.PhotoURL(PhotoExact(100, 100)))
+	assert.Equal(t, "", User{}.PhotoURL(PhotoExact(100, 100)))
+}
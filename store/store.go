@@ -0,0 +1,558 @@
+// Package store keeps venues, categories, tips, photos and lists from the
+// API on disk so they can be queried without a request and refreshed when
+// they get old.
+//
+// Each kind of record is a JSON file in the store's directory, loaded on
+// Open and written by Save. Every record has the time it was stored so
+// stale records can be found and fetched again. A file is an array of
+// rows sorted by their key, like a table:
+//
+//	venues.json      venue (key venue.id), details, updatedAt
+//	categories.json  category (key category.id), parentId, updatedAt
+//	tips.json        venueId, tip (key tip.id), updatedAt
+//	photos.json      venueId, photo (key photo.id), updatedAt
+//	lists.json       venueIds, list (key list.id), updatedAt
+//
+//	s, err := store.Open("data")
+//	defer s.Close()
+//
+//	venues, _, err := client.Venues.Search(params)
+//	s.UpsertVenues(venues...)
+//	nearby := s.Venues(store.Query{Bounds: &bounds, CategoryID: "4bf58dd8d48988d1e0931735"})
+//	for _, id := range s.StaleVenues(24 * time.Hour) { ... }
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/geo"
+)
+
+// Files of the store, one per kind of record.
+const (
+	venuesFile     = "venues.json"
+	categoriesFile = "categories.json"
+	tipsFile       = "tips.json"
+	photosFile     = "photos.json"
+	listsFile      = "lists.json"
+)
+
+// VenueRecord is a stored venue.
+type VenueRecord struct {
+	Venue foursquarego.Venue `json:"venue"`
+	// Details is true if the venue came from VenueService.Details and has
+	// every field, false for the compact venues of search and other lists.
+	Details   bool      `json:"details,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CategoryRecord is a stored category. Categories are stored flat, the
+// tree is kept with ParentID.
+type CategoryRecord struct {
+	Category  foursquarego.Category `json:"category"`
+	ParentID  string                `json:"parentId,omitempty"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+}
+
+// TipRecord is a stored tip and the venue it is on.
+type TipRecord struct {
+	VenueID   string           `json:"venueId"`
+	Tip       foursquarego.Tip `json:"tip"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// PhotoRecord is a stored photo and the venue it is of.
+type PhotoRecord struct {
+	VenueID   string             `json:"venueId"`
+	Photo     foursquarego.Photo `json:"photo"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// ListRecord is a stored list and the venues it was listed for.
+type ListRecord struct {
+	VenueIDs  []string          `json:"venueIds"`
+	List      foursquarego.List `json:"list"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// Store is a directory of records. It is safe for concurrent use.
+type Store struct {
+	dir string
+	now func() time.Time
+
+	mu         sync.RWMutex
+	venues     map[string]*VenueRecord
+	categories map[string]*CategoryRecord
+	tips       map[string]*TipRecord
+	photos     map[string]*PhotoRecord
+	lists      map[string]*ListRecord
+	dirty      map[string]bool
+}
+
+// Open loads the store in dir, creating the directory if needed.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		dir:        dir,
+		now:        time.Now,
+		venues:     map[string]*VenueRecord{},
+		categories: map[string]*CategoryRecord{},
+		tips:       map[string]*TipRecord{},
+		photos:     map[string]*PhotoRecord{},
+		lists:      map[string]*ListRecord{},
+		dirty:      map[string]bool{},
+	}
+
+	var venues []*VenueRecord
+	var categories []*CategoryRecord
+	var tips []*TipRecord
+	var photos []*PhotoRecord
+	var lists []*ListRecord
+	for file, v := range map[string]interface{}{
+		venuesFile:     &venues,
+		categoriesFile: &categories,
+		tipsFile:       &tips,
+		photosFile:     &photos,
+		listsFile:      &lists,
+	} {
+		if err := s.load(file, v); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range venues {
+		s.venues[r.Venue.ID] = r
+	}
+	for _, r := range categories {
+		s.categories[r.Category.ID] = r
+	}
+	for _, r := range tips {
+		s.tips[r.Tip.ID] = r
+	}
+	for _, r := range photos {
+		s.photos[r.Photo.ID] = r
+	}
+	for _, r := range lists {
+		s.lists[r.List.ID] = r
+	}
+	return s, nil
+}
+
+func (s *Store) load(file string, v interface{}) error {
+	b, err := ioutil.ReadFile(filepath.Join(s.dir, file))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Save writes the kinds of records that changed since the last Save.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for file := range s.dirty {
+		var records interface{}
+		switch file {
+		case venuesFile:
+			records = sortedVenues(s.venues)
+		case categoriesFile:
+			records = sortedCategories(s.categories)
+		case tipsFile:
+			records = sortedTips(s.tips)
+		case photosFile:
+			records = sortedPhotos(s.photos)
+		case listsFile:
+			records = sortedLists(s.lists)
+		}
+		if err := s.write(file, records); err != nil {
+			return err
+		}
+		delete(s.dirty, file)
+	}
+	return nil
+}
+
+// write replaces the file through a temporary file so a crash never
+// leaves half of it.
+func (s *Store) write(file string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, "."+file+".tmp")
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.dir, file))
+}
+
+// Close saves the store.
+func (s *Store) Close() error {
+	return s.Save()
+}
+
+// UpsertVenues adds compact venues, such as search results, or replaces
+// the stored copies. A venue stored by UpsertVenueDetails is kept along
+// with its UpdatedAt, fetch it with Details again when it is stale. The
+// categories of the venues are stored too.
+func (s *Store) UpsertVenues(venues ...foursquarego.Venue) {
+	s.upsertVenues(false, venues)
+}
+
+// UpsertVenueDetails adds the venues from VenueService.Details or replaces
+// the stored copies.
+func (s *Store) UpsertVenueDetails(venues ...foursquarego.Venue) {
+	s.upsertVenues(true, venues)
+}
+
+func (s *Store) upsertVenues(details bool, venues []foursquarego.Venue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, v := range venues {
+		if r, ok := s.venues[v.ID]; !ok || details || !r.Details {
+			s.venues[v.ID] = &VenueRecord{Venue: v, Details: details, UpdatedAt: now}
+		}
+		for _, c := range v.Categories {
+			if _, ok := s.categories[c.ID]; !ok {
+				c.Primary = false
+				s.categories[c.ID] = &CategoryRecord{Category: c, UpdatedAt: now}
+				s.dirty[categoriesFile] = true
+			}
+		}
+	}
+	s.dirty[venuesFile] = true
+}
+
+// UpsertCategories stores the category tree from VenueService.Categories,
+// replacing stored categories with the same ID.
+func (s *Store) UpsertCategories(categories ...foursquarego.Category) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var walk func(parentID string, cats []foursquarego.Category)
+	walk = func(parentID string, cats []foursquarego.Category) {
+		for _, c := range cats {
+			children := c.Categories
+			c.Categories = nil
+			s.categories[c.ID] = &CategoryRecord{Category: c, ParentID: parentID, UpdatedAt: now}
+			walk(c.ID, children)
+		}
+	}
+	walk("", categories)
+	s.dirty[categoriesFile] = true
+}
+
+// UpsertTips stores tips on the venue.
+func (s *Store) UpsertTips(venueID string, tips ...foursquarego.Tip) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, t := range tips {
+		s.tips[t.ID] = &TipRecord{VenueID: venueID, Tip: t, UpdatedAt: now}
+	}
+	s.dirty[tipsFile] = true
+}
+
+// UpsertPhotos stores photos of the venue.
+func (s *Store) UpsertPhotos(venueID string, photos ...foursquarego.Photo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, p := range photos {
+		s.photos[p.ID] = &PhotoRecord{VenueID: venueID, Photo: p, UpdatedAt: now}
+	}
+	s.dirty[photosFile] = true
+}
+
+// UpsertLists stores lists the venue is on, such as the items of
+// VenueService.Listed. A list on several venues is stored once.
+func (s *Store) UpsertLists(venueID string, lists ...foursquarego.List) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, l := range lists {
+		r, ok := s.lists[l.ID]
+		if !ok {
+			r = &ListRecord{}
+			s.lists[l.ID] = r
+		}
+		r.List = l
+		r.UpdatedAt = now
+		if !contains(r.VenueIDs, venueID) {
+			r.VenueIDs = append(r.VenueIDs, venueID)
+		}
+	}
+	s.dirty[listsFile] = true
+}
+
+// DeleteVenue removes a venue and its tips and photos, and takes it off
+// the stored lists. Lists left without venues are removed.
+func (s *Store) DeleteVenue(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.venues, id)
+	for tipID, t := range s.tips {
+		if t.VenueID == id {
+			delete(s.tips, tipID)
+		}
+	}
+	for photoID, p := range s.photos {
+		if p.VenueID == id {
+			delete(s.photos, photoID)
+		}
+	}
+	for listID, l := range s.lists {
+		kept := l.VenueIDs[:0]
+		for _, venueID := range l.VenueIDs {
+			if venueID != id {
+				kept = append(kept, venueID)
+			}
+		}
+		l.VenueIDs = kept
+		if len(kept) == 0 {
+			delete(s.lists, listID)
+		}
+	}
+	s.dirty[venuesFile] = true
+	s.dirty[tipsFile] = true
+	s.dirty[photosFile] = true
+	s.dirty[listsFile] = true
+}
+
+// Venue returns a stored venue.
+func (s *Store) Venue(id string) (VenueRecord, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.venues[id]
+	if !ok {
+		return VenueRecord{}, false
+	}
+	return *r, true
+}
+
+// Query selects venues, every field that is set must match.
+type Query struct {
+	// Bounds the venue's location is in.
+	Bounds *foursquarego.Bounds
+	// CategoryID is a category of the venue or one of its parents.
+	CategoryID string
+	// UpdatedAfter only selects venues stored after the time.
+	UpdatedAfter time.Time
+}
+
+// Venues returns the stored venues matching the query sorted by ID.
+func (s *Store) Venues(q Query) []VenueRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []VenueRecord
+	for _, r := range sortedVenues(s.venues) {
+		if q.Bounds != nil && !geo.Contains(*q.Bounds, geo.Point(r.Venue)) {
+			continue
+		}
+		if q.CategoryID != "" && !s.inCategory(r.Venue, q.CategoryID) {
+			continue
+		}
+		if !q.UpdatedAfter.IsZero() && !r.UpdatedAt.After(q.UpdatedAfter) {
+			continue
+		}
+		records = append(records, *r)
+	}
+	return records
+}
+
+// inCategory reports if any category of the venue is id or below it in
+// the stored category tree.
+func (s *Store) inCategory(v foursquarego.Venue, id string) bool {
+	for _, c := range v.Categories {
+		// The depth limit guards against a cycle in bad data.
+		for cur, depth := c.ID, 0; cur != "" && depth < 10; depth++ {
+			if cur == id {
+				return true
+			}
+			parent, ok := s.categories[cur]
+			if !ok {
+				break
+			}
+			cur = parent.ParentID
+		}
+	}
+	return false
+}
+
+// StaleVenues returns the IDs of venues stored longer than maxAge ago,
+// oldest first, to be fetched again.
+func (s *Store) StaleVenues(maxAge time.Duration) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cutoff := s.now().Add(-maxAge)
+	var stale []*VenueRecord
+	for _, r := range s.venues {
+		if r.UpdatedAt.Before(cutoff) {
+			stale = append(stale, r)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if !stale[i].UpdatedAt.Equal(stale[j].UpdatedAt) {
+			return stale[i].UpdatedAt.Before(stale[j].UpdatedAt)
+		}
+		return stale[i].Venue.ID < stale[j].Venue.ID
+	})
+
+	ids := make([]string, len(stale))
+	for i, r := range stale {
+		ids[i] = r.Venue.ID
+	}
+	return ids
+}
+
+// Category returns a stored category.
+func (s *Store) Category(id string) (CategoryRecord, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.categories[id]
+	if !ok {
+		return CategoryRecord{}, false
+	}
+	return *r, true
+}
+
+// Categories returns the stored categories directly under parentID, the
+// top level categories if it is empty.
+func (s *Store) Categories(parentID string) []CategoryRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []CategoryRecord
+	for _, r := range sortedCategories(s.categories) {
+		if r.ParentID == parentID {
+			records = append(records, *r)
+		}
+	}
+	return records
+}
+
+// Tips returns the stored tips on a venue, newest first.
+func (s *Store) Tips(venueID string) []TipRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []TipRecord
+	for _, r := range s.tips {
+		if r.VenueID == venueID {
+			records = append(records, *r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Tip.CreatedAt.After(records[j].Tip.CreatedAt.Time)
+	})
+	return records
+}
+
+// Photos returns the stored photos of a venue, newest first.
+func (s *Store) Photos(venueID string) []PhotoRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []PhotoRecord
+	for _, r := range s.photos {
+		if r.VenueID == venueID {
+			records = append(records, *r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Photo.CreatedAt.After(records[j].Photo.CreatedAt.Time)
+	})
+	return records
+}
+
+// Lists returns the stored lists a venue is on sorted by ID.
+func (s *Store) Lists(venueID string) []ListRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []ListRecord
+	for _, r := range sortedLists(s.lists) {
+		if contains(r.VenueIDs, venueID) {
+			records = append(records, *r)
+		}
+	}
+	return records
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedVenues(m map[string]*VenueRecord) []*VenueRecord {
+	records := make([]*VenueRecord, 0, len(m))
+	for _, r := range m {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Venue.ID < records[j].Venue.ID })
+	return records
+}
+
+func sortedCategories(m map[string]*CategoryRecord) []*CategoryRecord {
+	records := make([]*CategoryRecord, 0, len(m))
+	for _, r := range m {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Category.ID < records[j].Category.ID })
+	return records
+}
+
+func sortedTips(m map[string]*TipRecord) []*TipRecord {
+	records := make([]*TipRecord, 0, len(m))
+	for _, r := range m {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Tip.ID < records[j].Tip.ID })
+	return records
+}
+
+func sortedPhotos(m map[string]*PhotoRecord) []*PhotoRecord {
+	records := make([]*PhotoRecord, 0, len(m))
+	for _, r := range m {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Photo.ID < records[j].Photo.ID })
+	return records
+}
+
+func sortedLists(m map[string]*ListRecord) []*ListRecord {
+	records := make([]*ListRecord, 0, len(m))
+	for _, r := range m {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].List.ID < records[j].List.ID })
+	return records
+}
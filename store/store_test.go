@@ -0,0 +1,174 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/peppage/foursquarego"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	food  = foursquarego.Category{ID: "food", Name: "Food"}
+	pizza = foursquarego.Category{ID: "pizza", Name: "Pizza Place"}
+	bar   = foursquarego.Category{ID: "bar", Name: "Bar"}
+
+	joes = foursquarego.Venue{
+		ID:         "joes",
+		Name:       "Joe's Pizza",
+		Location:   foursquarego.Location{Lat: 40.7305, Lng: -74.0021},
+		Categories: []foursquarego.Category{pizza},
+	}
+	threes = foursquarego.Venue{
+		ID:         "threes",
+		Name:       "Threes Brewing",
+		Location:   foursquarego.Location{Lat: 40.6798, Lng: -73.9822},
+		Categories: []foursquarego.Category{bar},
+	}
+)
+
+func testStore(t *testing.T) (*Store, string, *time.Time) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, dir, &now
+}
+
+func TestStore_Venues(t *testing.T) {
+	s, dir, now := testStore(t)
+	defer os.RemoveAll(dir)
+
+	tree := food
+	tree.Categories = []foursquarego.Category{pizza}
+	s.UpsertCategories(tree)
+	s.UpsertVenues(joes, threes)
+
+	r, ok := s.Venue("joes")
+	assert.True(t, ok)
+	assert.Equal(t, "Joe's Pizza", r.Venue.Name)
+	assert.Equal(t, *now, r.UpdatedAt)
+
+	bar, ok := s.Category("bar")
+	assert.True(t, ok)
+	assert.Equal(t, "", bar.ParentID)
+	assert.Len(t, s.Categories("food"), 1)
+
+	ids := func(records []VenueRecord) []string {
+		var ids []string
+		for _, r := range records {
+			ids = append(ids, r.Venue.ID)
+		}
+		return ids
+	}
+
+	manhattan := foursquarego.Bounds{
+		Sw: foursquarego.LatLong{Lat: 40.70, Lng: -74.02},
+		Ne: foursquarego.LatLong{Lat: 40.80, Lng: -73.93},
+	}
+	assert.Equal(t, []string{"joes", "threes"}, ids(s.Venues(Query{})))
+	assert.Equal(t, []string{"joes"}, ids(s.Venues(Query{Bounds: &manhattan})))
+	assert.Equal(t, []string{"joes"}, ids(s.Venues(Query{CategoryID: "food"})))
+	assert.Equal(t, []string{"threes"}, ids(s.Venues(Query{CategoryID: "bar"})))
+	assert.Empty(t, s.Venues(Query{Bounds: &manhattan, CategoryID: "bar"}))
+
+	*now = now.Add(2 * time.Hour)
+	updated := joes
+	updated.Name = "Joe's Pizza & Pasta"
+	s.UpsertVenues(updated)
+
+	assert.Equal(t, []string{"joes"}, ids(s.Venues(Query{UpdatedAfter: now.Add(-time.Hour)})))
+	assert.Equal(t, []string{"threes"}, s.StaleVenues(time.Hour))
+	assert.Empty(t, s.StaleVenues(3*time.Hour))
+}
+
+func TestStore_UpsertVenueDetails(t *testing.T) {
+	s, dir, now := testStore(t)
+	defer os.RemoveAll(dir)
+
+	full := joes
+	full.Description = "Classic slices since 1975."
+	s.UpsertVenueDetails(full)
+
+	stored := *now
+	*now = now.Add(2 * time.Hour)
+	compact := joes
+	compact.Name = "Joe's"
+	s.UpsertVenues(compact, threes)
+
+	r, ok := s.Venue("joes")
+	assert.True(t, ok)
+	assert.True(t, r.Details)
+	assert.Equal(t, "Classic slices since 1975.", r.Venue.Description)
+	assert.Equal(t, stored, r.UpdatedAt)
+	assert.Equal(t, []string{"joes"}, s.StaleVenues(time.Hour))
+
+	s.UpsertVenueDetails(compact)
+	r, _ = s.Venue("joes")
+	assert.Equal(t, "Joe's", r.Venue.Name)
+	assert.Equal(t, *now, r.UpdatedAt)
+
+	r, _ = s.Venue("threes")
+	assert.False(t, r.Details)
+}
+
+func TestStore_persist(t *testing.T) {
+	s, dir, _ := testStore(t)
+	defer os.RemoveAll(dir)
+
+	s.UpsertVenues(joes)
+	s.UpsertTips("joes",
+		foursquarego.Tip{ID: "t1", Text: "old", CreatedAt: foursquarego.Timestamp{Time: time.Unix(100, 0)}},
+		foursquarego.Tip{ID: "t2", Text: "new", CreatedAt: foursquarego.Timestamp{Time: time.Unix(200, 0)}},
+	)
+	s.UpsertPhotos("joes", foursquarego.Photo{ID: "p1"})
+	s.UpsertLists("joes", foursquarego.List{ID: "l1", Name: "Best Pizza"})
+	s.UpsertLists("threes", foursquarego.List{ID: "l1", Name: "Best Pizza in NYC"})
+	assert.Nil(t, s.Close())
+
+	s, err := Open(dir)
+	assert.Nil(t, err)
+
+	r, ok := s.Venue("joes")
+	assert.True(t, ok)
+	assert.Equal(t, "Joe's Pizza", r.Venue.Name)
+	_, ok = s.Category("pizza")
+	assert.True(t, ok)
+
+	tips := s.Tips("joes")
+	if assert.Len(t, tips, 2) {
+		assert.Equal(t, "new", tips[0].Tip.Text)
+	}
+	assert.Len(t, s.Photos("joes"), 1)
+
+	lists := s.Lists("threes")
+	if assert.Len(t, lists, 1) {
+		assert.Equal(t, "Best Pizza in NYC", lists[0].List.Name)
+		assert.Equal(t, []string{"joes", "threes"}, lists[0].VenueIDs)
+	}
+
+	s.DeleteVenue("joes")
+	assert.Nil(t, s.Save())
+	s, err = Open(dir)
+	assert.Nil(t, err)
+	_, ok = s.Venue("joes")
+	assert.False(t, ok)
+	assert.Empty(t, s.Tips("joes"))
+	assert.Empty(t, s.Photos("joes"))
+	assert.Empty(t, s.Lists("joes"))
+	lists = s.Lists("threes")
+	if assert.Len(t, lists, 1) {
+		assert.Equal(t, []string{"threes"}, lists[0].VenueIDs)
+	}
+
+	s.DeleteVenue("threes")
+	assert.Empty(t, s.lists)
+}
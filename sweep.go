@@ -0,0 +1,192 @@
+package foursquarego
+
+const defaultMinTileSize = 0.001
+
+// SweepParams are the parameters for VenueService.Sweep
+type SweepParams struct {
+	Bounds     Bounds
+	Query      string
+	CategoryID []string
+	// Limit per tile, defaults to the maximum of 50. A tile returning this
+	// many venues is split into four.
+	Limit int
+	// MinTileSize in degrees, tiles smaller than this are not split any
+	// further. Defaults to 0.001, about 100m.
+	MinTileSize float64
+	// Checkpoint to resume a sweep from.
+	Checkpoint *SweepCheckpoint
+	// Progress is called after every tile.
+	Progress func(SweepProgress)
+}
+
+// SweepCheckpoint is the state of a sweep. Save it with
+// SweepProgress.Checkpoint from the progress callback or from the error
+// return of Sweep and pass it back in SweepParams to resume.
+type SweepCheckpoint struct {
+	Pending []Bounds `json:"pending"`
+	Seen    []string `json:"seen"`
+	// Truncated are the tiles that still hit the limit at MinTileSize, they
+	// may have more venues than were found.
+	Truncated []Bounds `json:"truncated,omitempty"`
+}
+
+// SweepProgress is sent to SweepParams.Progress after every tile.
+type SweepProgress struct {
+	Tile      Bounds
+	Found     int
+	New       int
+	Split     bool
+	Truncated bool
+	TilesDone int
+	Pending   int
+	Venues    int
+
+	checkpoint func() *SweepCheckpoint
+}
+
+// Checkpoint copies the state of the sweep after the tile. It copies every
+// venue ID seen so call it only when saving, and only from the callback.
+func (p SweepProgress) Checkpoint() *SweepCheckpoint {
+	if p.checkpoint == nil {
+		return nil
+	}
+	return p.checkpoint()
+}
+
+// Sweep finds every venue in the bounds. It searches with intent browse
+// and splits any tile that hits the limit into four until tiles are
+// MinTileSize. Venues are deduplicated by ID. Bounds may cross the
+// antimeridian, with Sw.Lng east of Ne.Lng.
+//
+// Tiles that hit the limit at MinTileSize cannot be split and are listed
+// in the checkpoint's Truncated, venues in them may be missing.
+//
+// On error the venues found so far are returned with a checkpoint to
+// resume from. A resumed sweep only returns venues it had not seen.
+func (s *VenueService) Sweep(params *SweepParams) ([]Venue, *SweepCheckpoint, error) {
+	if err := params.Bounds.Validate(); err != nil {
+		return nil, nil, &ParamError{Param: "sw", Detail: err.Error()}
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	minSize := params.MinTileSize
+	if minSize <= 0 {
+		minSize = defaultMinTileSize
+	}
+
+	pending := []Bounds{params.Bounds}
+	seen := map[string]bool{}
+	var seenOrder []string
+	var truncated []Bounds
+	if params.Checkpoint != nil {
+		pending = append([]Bounds(nil), params.Checkpoint.Pending...)
+		for _, id := range params.Checkpoint.Seen {
+			seen[id] = true
+		}
+		seenOrder = append(seenOrder, params.Checkpoint.Seen...)
+		truncated = append(truncated, params.Checkpoint.Truncated...)
+	}
+
+	checkpoint := func() *SweepCheckpoint {
+		return &SweepCheckpoint{
+			Pending:   append([]Bounds(nil), pending...),
+			Seen:      append([]string(nil), seenOrder...),
+			Truncated: append([]Bounds(nil), truncated...),
+		}
+	}
+
+	var venues []Venue
+	done := 0
+	for len(pending) > 0 {
+		tile := pending[len(pending)-1]
+
+		found, _, err := s.Search(&VenueSearchParams{
+			Intent:     IntentBrowse,
+			Bounds:     &tile,
+			Query:      params.Query,
+			CategoryID: params.CategoryID,
+			Limit:      limit,
+		})
+		if err != nil {
+			return venues, checkpoint(), err
+		}
+		pending = pending[:len(pending)-1]
+		done++
+
+		full := len(found) >= limit
+		split := full && tileSize(tile) > minSize
+		if split {
+			pending = append(pending, splitTile(tile)...)
+		} else if full {
+			truncated = append(truncated, tile)
+		}
+
+		added := 0
+		for _, v := range found {
+			if seen[v.ID] {
+				continue
+			}
+			seen[v.ID] = true
+			seenOrder = append(seenOrder, v.ID)
+			venues = append(venues, v)
+			added++
+		}
+
+		if params.Progress != nil {
+			params.Progress(SweepProgress{
+				Tile:       tile,
+				Found:      len(found),
+				New:        added,
+				Split:      split,
+				Truncated:  full && !split,
+				TilesDone:  done,
+				Pending:    len(pending),
+				Venues:     len(venues),
+				checkpoint: checkpoint,
+			})
+		}
+	}
+
+	return venues, checkpoint(), nil
+}
+
+// tileSize is the longest side of the tile in degrees.
+func tileSize(b Bounds) float64 {
+	lat := b.Ne.Lat - b.Sw.Lat
+	lng := lngSpan(b)
+	if lat > lng {
+		return lat
+	}
+	return lng
+}
+
+// lngSpan is the width of the tile in degrees going east from Sw to Ne,
+// so a tile crossing the antimeridian is not negative.
+func lngSpan(b Bounds) float64 {
+	span := b.Ne.Lng - b.Sw.Lng
+	if span < 0 {
+		span += 360
+	}
+	return span
+}
+
+// splitTile splits the tile into four quarters.
+func splitTile(b Bounds) []Bounds {
+	midLng := b.Sw.Lng + lngSpan(b)/2
+	if midLng > 180 {
+		midLng -= 360
+	}
+	mid := LatLong{
+		Lat: (b.Sw.Lat + b.Ne.Lat) / 2,
+		Lng: midLng,
+	}
+	return []Bounds{
+		{Sw: b.Sw, Ne: mid},
+		{Sw: LatLong{Lat: b.Sw.Lat, Lng: mid.Lng}, Ne: LatLong{Lat: mid.Lat, Lng: b.Ne.Lng}},
+		{Sw: LatLong{Lat: mid.Lat, Lng: b.Sw.Lng}, Ne: LatLong{Lat: b.Ne.Lat, Lng: mid.Lng}},
+		{Sw: mid, Ne: b.Ne},
+	}
+}
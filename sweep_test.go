@@ -0,0 +1,168 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sweepServer serves search results from a grid of 100 venues between
+// 40,-74 and 40.01,-73.99.
+func sweepServer(t *testing.T, failAfter int) (*http.Client, func() int, func()) {
+	httpClient, mux, server := testServer()
+
+	var grid []Venue
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			grid = append(grid, Venue{
+				ID:       fmt.Sprintf("v%d%d", i, j),
+				Location: Location{Lat: 40 + 0.001*float64(i) + 0.0005, Lng: -74 + 0.001*float64(j) + 0.0005},
+			})
+		}
+	}
+
+	requests := 0
+	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failAfter > 0 && requests > failAfter {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"meta":{"code":403,"errorType":"quota_exceeded","errorDetail":"Quota exceeded"},"response":{}}`))
+			return
+		}
+
+		q := r.URL.Query()
+		assert.Equal(t, "browse", q.Get("intent"))
+		sw, _ := ParseLatLong(q.Get("sw"))
+		ne, _ := ParseLatLong(q.Get("ne"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		var venues []Venue
+		for _, v := range grid {
+			l := v.Location
+			if l.Lat >= sw.Lat && l.Lat <= ne.Lat && l.Lng >= sw.Lng && l.Lng <= ne.Lng && len(venues) < limit {
+				venues = append(venues, v)
+			}
+		}
+
+		b, _ := json.Marshal(map[string]interface{}{
+			"meta":     Meta{Code: 200},
+			"response": map[string]interface{}{"venues": venues},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+
+	return httpClient, func() int { return requests }, server.Close
+}
+
+func TestVenueService_Sweep(t *testing.T) {
+	httpClient, requests, closeServer := sweepServer(t, 0)
+	defer closeServer()
+
+	var progress []SweepProgress
+	var saved *SweepCheckpoint
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, checkpoint, err := client.Venues.Sweep(&SweepParams{
+		Bounds: Bounds{Sw: LatLong{40, -74}, Ne: LatLong{40.01, -73.99}},
+		Limit:  30,
+		Progress: func(p SweepProgress) {
+			progress = append(progress, p)
+			if p.TilesDone == 1 {
+				saved = p.Checkpoint()
+			}
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 100)
+	assert.Equal(t, 5, requests())
+	assert.True(t, progress[0].Split)
+	assert.Equal(t, 4, progress[0].Pending)
+	assert.Equal(t, 100, progress[len(progress)-1].Venues)
+	assert.Len(t, checkpoint.Pending, 0)
+	assert.Len(t, checkpoint.Seen, 100)
+
+	assert.Len(t, saved.Pending, 4)
+	assert.Len(t, saved.Seen, progress[0].Venues)
+}
+
+func TestVenueService_SweepResume(t *testing.T) {
+	httpClient, _, closeServer := sweepServer(t, 3)
+	defer closeServer()
+
+	params := &SweepParams{
+		Bounds: Bounds{Sw: LatLong{40, -74}, Ne: LatLong{40.01, -73.99}},
+		Limit:  30,
+	}
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	first, checkpoint, err := client.Venues.Sweep(params)
+	assert.IsType(t, &APIError{}, err)
+	assert.Len(t, checkpoint.Pending, 2)
+
+	// The checkpoint survives being saved as json.
+	b, err := json.Marshal(checkpoint)
+	assert.Nil(t, err)
+	params.Checkpoint = new(SweepCheckpoint)
+	assert.Nil(t, json.Unmarshal(b, params.Checkpoint))
+
+	httpClient, _, closeServer = sweepServer(t, 0)
+	defer closeServer()
+
+	client = NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	rest, _, err := client.Venues.Sweep(params)
+	assert.Nil(t, err)
+
+	seen := map[string]bool{}
+	for _, v := range append(first, rest...) {
+		assert.False(t, seen[v.ID], v.ID)
+		seen[v.ID] = true
+	}
+	assert.Len(t, seen, 100)
+}
+
+func TestVenueService_SweepTruncated(t *testing.T) {
+	httpClient, requests, closeServer := sweepServer(t, 0)
+	defer closeServer()
+
+	var progress []SweepProgress
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, checkpoint, err := client.Venues.Sweep(&SweepParams{
+		Bounds:      Bounds{Sw: LatLong{40, -74}, Ne: LatLong{40.01, -73.99}},
+		Limit:       30,
+		MinTileSize: 0.02,
+		Progress: func(p SweepProgress) {
+			progress = append(progress, p)
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.Len(t, venues, 30)
+	assert.Equal(t, 1, requests())
+	assert.False(t, progress[0].Split)
+	assert.True(t, progress[0].Truncated)
+	assert.Equal(t, []Bounds{{Sw: LatLong{40, -74}, Ne: LatLong{40.01, -73.99}}}, checkpoint.Truncated)
+}
+
+func TestSplitTile_antimeridian(t *testing.T) {
+	tile := Bounds{Sw: LatLong{-20, 170}, Ne: LatLong{-10, -170}}
+	assert.Equal(t, 20.0, tileSize(tile))
+
+	quarters := splitTile(tile)
+	assert.Equal(t, []Bounds{
+		{Sw: LatLong{-20, 170}, Ne: LatLong{-15, 180}},
+		{Sw: LatLong{-20, 180}, Ne: LatLong{-15, -170}},
+		{Sw: LatLong{-15, 170}, Ne: LatLong{-10, 180}},
+		{Sw: LatLong{-15, 180}, Ne: LatLong{-10, -170}},
+	}, quarters)
+	for _, q := range quarters {
+		assert.Equal(t, 10.0, tileSize(q))
+	}
+
+	quarters = splitTile(Bounds{Sw: LatLong{-20, 175}, Ne: LatLong{-10, -165}})
+	assert.Equal(t, -175.0, quarters[0].Ne.Lng)
+}
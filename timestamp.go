@@ -0,0 +1,64 @@
+package foursquarego
+
+import (
+	"strconv"
+	"time"
+)
+
+// Timestamp is a time foursquare sends as seconds since the epoch.
+// A missing or 0 value is the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON reads epoch seconds.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if sec == 0 {
+		t.Time = time.Time{}
+	} else {
+		t.Time = time.Unix(sec, 0).UTC()
+	}
+	return nil
+}
+
+// MarshalJSON writes epoch seconds the same way foursquare sends them.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// LocalTime returns t in the time zone of the venue. If the venue has
+// no known TimeZone t is returned in UTC.
+func (v *Venue) LocalTime(t Timestamp) time.Time {
+	return inZone(t, v.TimeZone)
+}
+
+// LocalTime returns t in the time zone of the event. If the event has
+// no known TimeZone t is returned in UTC.
+func (e *Event) LocalTime(t Timestamp) time.Time {
+	return inZone(t, e.TimeZone)
+}
+
+func inZone(t Timestamp, name string) time.Time {
+	if name == "" {
+		return t.Time
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return t.Time
+	}
+	return t.In(loc)
+}
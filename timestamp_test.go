@@ -0,0 +1,37 @@
+package foursquarego
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp_JSON(t *testing.T) {
+	var v struct {
+		CreatedAt Timestamp `json:"createdAt"`
+		UpdatedAt Timestamp `json:"updatedAt"`
+	}
+	err := json.Unmarshal([]byte(`{"createdAt": 1410650278, "updatedAt": 0}`), &v)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int64(1410650278), v.CreatedAt.Unix())
+	assert.Equal(t, "2014-09-13T23:17:58Z", v.CreatedAt.Format(time.RFC3339))
+	assert.True(t, v.UpdatedAt.IsZero())
+
+	b, err := json.Marshal(v)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"createdAt": 1410650278, "updatedAt": 0}`, string(b))
+}
+
+func TestVenue_LocalTime(t *testing.T) {
+	var ts Timestamp
+	json.Unmarshal([]byte(`1410650278`), &ts)
+
+	venue := &Venue{TimeZone: "America/New_York"}
+	assert.Equal(t, "2014-09-13T19:17:58-04:00", venue.LocalTime(ts).Format(time.RFC3339))
+
+	venue = &Venue{}
+	assert.Equal(t, "2014-09-13T23:17:58Z", venue.LocalTime(ts).Format(time.RFC3339))
+}
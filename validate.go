@@ -0,0 +1,220 @@
+package foursquarego
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Limits documented for the venue endpoints.
+const (
+	maxSearchLimit   = 50
+	maxSearchRadius  = 100000
+	maxSuggestLimit  = 100
+	minSuggestQuery  = 3
+	maxTrendingLimit = 50
+	maxTrendingRad   = 2000
+	maxExploreLimit  = 50
+	maxPhotosLimit   = 200
+	maxListedLimit   = 200
+	maxTipsLimit     = 500
+	maxCategoryIDs   = 50
+)
+
+// Validate checks the parameters against the documented rules for
+// VenueService.Search. It is called by Search before sending.
+func (p *VenueSearchParams) Validate() error {
+	if p == nil {
+		return &ParamError{Param: "ll", Detail: "ll or near is required"}
+	}
+	if err := validatePoint(p.LatLong, p.Point); err != nil {
+		return err
+	}
+	if err := validateBounds(p.Sw, p.Ne, p.Bounds); err != nil {
+		return err
+	}
+
+	hasLocation := p.LatLong != "" || p.Point != nil || p.Near != ""
+	hasBounds := p.Bounds != nil || (p.Sw != "" && p.Ne != "")
+
+	switch p.Intent {
+	case IntentGlobal:
+		if p.Query == "" {
+			return &ParamError{Param: "query", Detail: "query is required with intent global"}
+		}
+	case IntentMatch:
+		if p.LatLong == "" && p.Point == nil {
+			return &ParamError{Param: "ll", Detail: "ll is required with intent match"}
+		}
+		if p.Query == "" {
+			return &ParamError{Param: "query", Detail: "query is required with intent match"}
+		}
+	case IntentBrowse:
+		if !hasBounds && (!hasLocation || p.Radius == 0) {
+			return &ParamError{Param: "radius", Detail: "intent browse requires ll or near with radius, or sw and ne"}
+		}
+	default:
+		if !hasLocation {
+			return &ParamError{Param: "ll", Detail: "ll or near is required"}
+		}
+	}
+
+	if err := validateMax("limit", p.Limit, maxSearchLimit); err != nil {
+		return err
+	}
+	if err := validateMax("radius", p.Radius, maxSearchRadius); err != nil {
+		return err
+	}
+	return validateMax("categoryId", len(p.CategoryID), maxCategoryIDs)
+}
+
+// Validate checks the parameters against the documented rules for
+// VenueService.SuggestCompletion. It is called by SuggestCompletion
+// before sending.
+func (p *VenueSuggestParams) Validate() error {
+	if p == nil {
+		return &ParamError{Param: "ll", Detail: "ll or near is required"}
+	}
+	if err := validatePoint(p.LatLong, p.Point); err != nil {
+		return err
+	}
+	if err := validateBounds(p.Sw, p.Ne, p.Bounds); err != nil {
+		return err
+	}
+	if p.LatLong == "" && p.Point == nil && p.Near == "" {
+		return &ParamError{Param: "ll", Detail: "ll or near is required"}
+	}
+	if utf8.RuneCountInString(p.Query) < minSuggestQuery {
+		return &ParamError{Param: "query", Detail: fmt.Sprintf("query must be at least %d characters", minSuggestQuery)}
+	}
+	if err := validateMax("limit", p.Limit, maxSuggestLimit); err != nil {
+		return err
+	}
+	return validateMax("radius", p.Radius, maxSearchRadius)
+}
+
+// Validate checks the parameters against the documented rules for
+// VenueService.Trending. It is called by Trending before sending.
+func (p *VenueTrendingParams) Validate() error {
+	if p == nil {
+		return &ParamError{Param: "ll", Detail: "ll is required"}
+	}
+	if err := validatePoint(p.LatLong, p.Point); err != nil {
+		return err
+	}
+	if p.LatLong == "" && p.Point == nil {
+		return &ParamError{Param: "ll", Detail: "ll is required"}
+	}
+	if err := validateMax("limit", p.Limit, maxTrendingLimit); err != nil {
+		return err
+	}
+	return validateMax("radius", p.Radius, maxTrendingRad)
+}
+
+// Validate checks the parameters against the documented rules for
+// VenueService.Explore. It is called by Explore before sending.
+func (p *VenueExploreParams) Validate() error {
+	if p == nil {
+		return &ParamError{Param: "ll", Detail: "ll or near is required"}
+	}
+	if err := validatePoint(p.LatLong, p.Point); err != nil {
+		return err
+	}
+	if p.LatLong == "" && p.Point == nil && p.Near == "" {
+		return &ParamError{Param: "ll", Detail: "ll or near is required"}
+	}
+	if err := validateMax("limit", p.Limit, maxExploreLimit); err != nil {
+		return err
+	}
+	if err := validateMax("radius", p.Radius, maxSearchRadius); err != nil {
+		return err
+	}
+	for _, price := range p.Price {
+		if price < 1 || price > 4 {
+			return &ParamError{Param: "price", Detail: fmt.Sprintf("price %d is not a tier from 1 to 4", price)}
+		}
+	}
+	return validateMax("categoryId", len(p.CategoryID), maxCategoryIDs)
+}
+
+// Validate checks the parameters for VenueService.Photos. It is called by
+// Photos before sending.
+func (p *VenuePhotosParams) Validate() error {
+	if p == nil || p.VenueID == "" {
+		return &ParamError{Param: "VENUE_ID", Detail: "venue id is required"}
+	}
+	return validateMax("limit", p.Limit, maxPhotosLimit)
+}
+
+// Validate checks the parameters for VenueService.Listed. It is called by
+// Listed before sending.
+func (p *VenueListedParams) Validate() error {
+	if p == nil || p.VenueID == "" {
+		return &ParamError{Param: "VENUE_ID", Detail: "venue id is required"}
+	}
+	return validateMax("limit", p.Limit, maxListedLimit)
+}
+
+// Validate checks the parameters for VenueService.Tips. It is called by
+// Tips before sending.
+func (p *VenueTipsParams) Validate() error {
+	if p == nil || p.VenueID == "" {
+		return &ParamError{Param: "VENUE_ID", Detail: "venue id is required"}
+	}
+	return validateMax("limit", p.Limit, maxTipsLimit)
+}
+
+// validatePoint checks the ll string is formatted correctly and in range.
+// Point takes precedence over the string, so only it is checked when set.
+func validatePoint(ll string, point *LatLong) error {
+	if point != nil {
+		if err := point.Validate(); err != nil {
+			return &ParamError{Param: "ll", Detail: err.Error()}
+		}
+		return nil
+	}
+	if ll != "" {
+		if _, err := ParseLatLong(ll); err != nil {
+			return &ParamError{Param: "ll", Detail: err.Error()}
+		}
+	}
+	return nil
+}
+
+// validateBounds checks sw and ne are sent together and are valid.
+func validateBounds(sw, ne string, bounds *Bounds) error {
+	if bounds != nil {
+		if err := bounds.Validate(); err != nil {
+			return &ParamError{Param: "sw", Detail: err.Error()}
+		}
+		return nil
+	}
+	if (sw == "") != (ne == "") {
+		return &ParamError{Param: "sw", Detail: "sw and ne must be sent together"}
+	}
+	if sw == "" {
+		return nil
+	}
+
+	swLL, err := ParseLatLong(sw)
+	if err != nil {
+		return &ParamError{Param: "sw", Detail: err.Error()}
+	}
+	neLL, err := ParseLatLong(ne)
+	if err != nil {
+		return &ParamError{Param: "ne", Detail: err.Error()}
+	}
+	if err := (Bounds{Sw: swLL, Ne: neLL}).Validate(); err != nil {
+		return &ParamError{Param: "sw", Detail: err.Error()}
+	}
+	return nil
+}
+
+func validateMax(param string, value, max int) error {
+	if value < 0 {
+		return &ParamError{Param: param, Detail: "must not be negative"}
+	}
+	if value > max {
+		return &ParamError{Param: param, Detail: fmt.Sprintf("%d is over the maximum of %d", value, max)}
+	}
+	return nil
+}
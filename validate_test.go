@@ -0,0 +1,78 @@
+package foursquarego
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validator interface {
+	Validate() error
+}
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params validator
+		param  string
+	}{
+		{"search ll", &VenueSearchParams{LatLong: "40.7,-74"}, ""},
+		{"search near", &VenueSearchParams{Near: "Chicago, IL"}, ""},
+		{"search nil", (*VenueSearchParams)(nil), "ll"},
+		{"search no location", &VenueSearchParams{Query: "coffee"}, "ll"},
+		{"search bad ll", &VenueSearchParams{LatLong: "40.7;-74"}, "ll"},
+		{"search swapped ll", &VenueSearchParams{LatLong: "139.7,35.6"}, "ll"},
+		{"search point over bad ll", &VenueSearchParams{LatLong: "40.7;-74", Point: &LatLong{40.7, -74}}, ""},
+		{"search bad point", &VenueSearchParams{LatLong: "40.7,-74", Point: &LatLong{140.7, -74}}, "ll"},
+		{"search global", &VenueSearchParams{Intent: IntentGlobal, Query: "coffee"}, ""},
+		{"search global no query", &VenueSearchParams{Intent: IntentGlobal}, "query"},
+		{"search match", &VenueSearchParams{Intent: IntentMatch, LatLong: "40.7,-74", Query: "joe's pizza"}, ""},
+		{"search match near", &VenueSearchParams{Intent: IntentMatch, Near: "Chicago, IL", Query: "joe's pizza"}, "ll"},
+		{"search match no query", &VenueSearchParams{Intent: IntentMatch, LatLong: "40.7,-74"}, "query"},
+		{"search browse radius", &VenueSearchParams{Intent: IntentBrowse, LatLong: "40.7,-74", Radius: 500}, ""},
+		{"search browse bounds", &VenueSearchParams{Intent: IntentBrowse, Sw: "40.7,-74.1", Ne: "40.8,-73.9"}, ""},
+		{"search browse no radius", &VenueSearchParams{Intent: IntentBrowse, LatLong: "40.7,-74"}, "radius"},
+		{"search only sw", &VenueSearchParams{LatLong: "40.7,-74", Sw: "40.7,-74.1"}, "sw"},
+		{"search limit", &VenueSearchParams{LatLong: "40.7,-74", Limit: 51}, "limit"},
+		{"search radius", &VenueSearchParams{LatLong: "40.7,-74", Radius: 100001}, "radius"},
+		{"search categories", &VenueSearchParams{LatLong: "40.7,-74", CategoryID: make([]string, 51)}, "categoryId"},
+		{"suggest", &VenueSuggestParams{LatLong: "40.7,-74", Query: "fou"}, ""},
+		{"suggest short query", &VenueSuggestParams{LatLong: "40.7,-74", Query: "fo"}, "query"},
+		{"suggest short cjk query", &VenueSuggestParams{LatLong: "35.6,139.7", Query: "寿司"}, "query"},
+		{"suggest cjk query", &VenueSuggestParams{LatLong: "35.6,139.7", Query: "回転寿"}, ""},
+		{"suggest limit", &VenueSuggestParams{Near: "Chicago, IL", Query: "foursquare", Limit: 101}, "limit"},
+		{"trending", &VenueTrendingParams{Point: &LatLong{40.7, -74}}, ""},
+		{"trending near only", &VenueTrendingParams{}, "ll"},
+		{"trending radius", &VenueTrendingParams{LatLong: "40.7,-74", Radius: 2001}, "radius"},
+		{"explore", &VenueExploreParams{Near: "Chicago, IL", Price: []int{1, 2}}, ""},
+		{"explore price", &VenueExploreParams{Near: "Chicago, IL", Price: []int{5}}, "price"},
+		{"explore limit", &VenueExploreParams{Near: "Chicago, IL", Limit: 51}, "limit"},
+		{"photos", &VenuePhotosParams{VenueID: "5414d0a6498ea3d31a3c64cf"}, ""},
+		{"photos no id", &VenuePhotosParams{}, "VENUE_ID"},
+		{"listed limit", &VenueListedParams{VenueID: "4f68de6bd5fbee32e5f4f3a5", Limit: 201}, "limit"},
+		{"tips negative limit", &VenueTipsParams{VenueID: "5557c94e498ebde0672e57f4", Limit: -1}, "limit"},
+	}
+
+	for _, test := range tests {
+		err := test.params.Validate()
+		if test.param == "" {
+			assert.Nil(t, err, test.name)
+			continue
+		}
+		if assert.IsType(t, &ParamError{}, err, test.name) {
+			assert.Equal(t, test.param, err.(*ParamError).Param, test.name)
+		}
+	}
+}
+
+func TestVenueService_SearchValidates(t *testing.T) {
+	httpClient, _, server := testServer()
+	defer server.Close()
+
+	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
+	venues, resp, err := client.Venues.Search(&VenueSearchParams{Query: "coffee"})
+
+	assert.Nil(t, venues)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "foursquare: invalid ll: ll or near is required")
+}
@@ -9,7 +9,8 @@ import (
 
 // VenueService provies a method for accessing Foursquare venue endpoints
 type VenueService struct {
-	sling *sling.Sling
+	sling  *sling.Sling
+	result *Result
 }
 
 func newVenueService(sling *sling.Sling) *VenueService {
@@ -26,73 +27,86 @@ type venueResp struct {
 
 // SetHeader sets a header to be sent with the request for internationalization
 // https://developer.foursquare.com/docs/api/configuration/versioning
-func (s *VenueService) SetHeader(key, value string) *VenueService {
+func (s *VenueService) SetHeader(key, value string) VenueAPI {
 	s.sling.Set(key, value)
 	return s
 }
 
+// WithResult returns a copy of the service that fills r with the envelope
+// of every response, r is overwritten on each request.
+//
+//	var result foursquarego.Result
+//	venue, _, err := client.Venues.WithResult(&result).Details(id)
+//	log.Print(result.Meta.RequestID)
+func (s *VenueService) WithResult(r *Result) VenueAPI {
+	return &VenueService{
+		sling:  s.sling.New(),
+		result: r,
+	}
+}
+
 // Details gets all the data for a venue
 // https://developer.foursquare.com/docs/api/venues/details
 func (s *VenueService) Details(id string) (*Venue, *http.Response, error) {
-	response := new(Response)
 	venue := new(venueResp)
-
-	resp, err := s.sling.New().Get(id).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, venue)
-	}
-	return &venue.Venue, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id), EndpointVenueDetails, venue, s.result)
+	return &venue.Venue, resp, err
 }
 
 // Venue represents a foursquare Venue.
 // https://developer.foursquare.com/docs/api/venues/details
+//
+// Fields that are a json.RawMessage here and in the types below have not
+// been seen in a captured response, so they are kept as sent rather than
+// decoded into a guessed struct.
 type Venue struct {
-	ID               string       `json:"id"`
-	Name             string       `json:"name"`
-	Contact          Contact      `json:"contact"`
-	Location         Location     `json:"location"`
-	CanonicalURL     string       `json:"canonicalUrl"`
-	Categories       []Category   `json:"categories"`
-	Verified         bool         `json:"verified"`
-	Stats            Stats        `json:"stats"`
-	URL              string       `json:"url"`
-	Price            Price        `json:"price"`
-	HasMenu          bool         `json:"hasMenu"`
-	Likes            Likes        `json:"likes"`
-	Like             bool         `json:"like"`
-	Dislike          bool         `json:"dislike"`
-	Ok               bool         `json:"ok"`
-	Rating           float64      `json:"rating"`
-	RatingColor      string       `json:"ratingColor"`
-	RatingSignals    int          `json:"ratingSignals"`
-	Menu             Menu         `json:"menu"`
-	AllowMenuURLEdit bool         `json:"allowMenuUrlEdit"`
-	FriendVisits     FriendVisits `json:"friendVisits"`
-	BeenHere         BeenHere     `json:"beenHere"`
-	Specials         Omitted      `json:"Specials"`
-	Photos           Photos       `json:"photos"`
-	VenuePage        ID           `json:"venuePage"`
-	Reasons          Reasons      `json:"reasons"`
-	Description      string       `json:"description"`
-	StoreID          string       `json:"storeId"`
-	Page             Page         `json:"page"`
-	HereNow          HereNow      `json:"hereNow"`
-	CreatedAt        int64        `json:"createdAt"`
-	Tips             Tips         `json:"tips"`
-	ShortURL         string       `json:"shortUrl"`
-	TimeZone         string       `json:"timeZone"`
-	Listed           Listed       `json:"listed"`
-	Phrases          []Phrase     `json:"phrases"`
-	Hours            Hours        `json:"hours"`
-	Popular          Hours        `json:"popular"`
-	PageUpates       PageUpdates  `json:"pageUpdates"`
-	Inbox            Inbox        `json:"inbox"`
-	ReferralID       string       `json:"referralId"`
-	VenueChains      Omitted      `json:"venueChains"`
-	HasPerk          bool         `json:"hasPerk"`
-	Attributes       Attributes   `json:"attributes"`
-	BestPhoto        Photo        `json:"bestPhoto"`
-	Colors           Colors       `json:"colors"`
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Contact          Contact         `json:"contact"`
+	Location         Location        `json:"location"`
+	CanonicalURL     string          `json:"canonicalUrl"`
+	Categories       []Category      `json:"categories"`
+	Verified         bool            `json:"verified"`
+	Closed           bool            `json:"closed"`
+	Stats            Stats           `json:"stats"`
+	URL              string          `json:"url"`
+	Price            Price           `json:"price"`
+	HasMenu          bool            `json:"hasMenu"`
+	Likes            Likes           `json:"likes"`
+	Like             bool            `json:"like"`
+	Dislike          bool            `json:"dislike"`
+	Ok               bool            `json:"ok"`
+	Rating           float64         `json:"rating"`
+	RatingColor      string          `json:"ratingColor"`
+	RatingSignals    int             `json:"ratingSignals"`
+	Menu             Menu            `json:"menu"`
+	AllowMenuURLEdit bool            `json:"allowMenuUrlEdit"`
+	FriendVisits     FriendVisits    `json:"friendVisits"`
+	BeenHere         BeenHere        `json:"beenHere"`
+	Specials         json.RawMessage `json:"specials"`
+	Photos           Photos          `json:"photos"`
+	VenuePage        ID              `json:"venuePage"`
+	Reasons          Reasons         `json:"reasons"`
+	Description      string          `json:"description"`
+	StoreID          string          `json:"storeId"`
+	Page             Page            `json:"page"`
+	HereNow          HereNow         `json:"hereNow"`
+	CreatedAt        Timestamp       `json:"createdAt"`
+	Tips             Tips            `json:"tips"`
+	ShortURL         string          `json:"shortUrl"`
+	TimeZone         string          `json:"timeZone"`
+	Listed           Listed          `json:"listed"`
+	Phrases          []Phrase        `json:"phrases"`
+	Hours            Hours           `json:"hours"`
+	Popular          Hours           `json:"popular"`
+	PageUpates       PageUpdates     `json:"pageUpdates"`
+	Inbox            Inbox           `json:"inbox"`
+	ReferralID       string          `json:"referralId"`
+	VenueChains      json.RawMessage `json:"venueChains"`
+	HasPerk          bool            `json:"hasPerk"`
+	Attributes       Attributes      `json:"attributes"`
+	BestPhoto        Photo           `json:"bestPhoto"`
+	Colors           Colors          `json:"colors"`
 }
 
 // Contact are details to contact this venue. Can contain all or none.
@@ -231,11 +245,11 @@ type Lists struct {
 // BeenHere contains the number of times the acting user has
 // been to the venue. Absent if there is no acting user.
 type BeenHere struct {
-	Count                int   `json:"count"`
-	UnconfirmedCount     int   `json:"unconfirmedCount"`
-	Marked               bool  `json:"marked"`
-	LastVisitedAt        int64 `json:"lastVisitedAt"`
-	LastCheckinExpiredAt int64 `json:"lastCheckinExpiredAt"`
+	Count                int       `json:"count"`
+	UnconfirmedCount     int       `json:"unconfirmedCount"`
+	Marked               bool      `json:"marked"`
+	LastVisitedAt        Timestamp `json:"lastVisitedAt"`
+	LastCheckinExpiredAt Timestamp `json:"lastCheckinExpiredAt"`
 }
 
 // Photos contains a count and groups of photos for the venue.
@@ -254,7 +268,7 @@ type PhotoGrouping struct {
 // https://developer.foursquare.com/docs/api/photos/details
 type Photo struct {
 	ID         string      `json:"id"`
-	CreatedAt  int         `json:"createdAt"`
+	CreatedAt  Timestamp   `json:"createdAt"`
 	Source     PhotoSource `json:"source"`
 	Prefix     string      `json:"prefix"`
 	Suffix     string      `json:"suffix"`
@@ -324,7 +338,7 @@ type HereNow struct {
 // HereNowGroup is the groups item in HereNow.
 type HereNowGroup struct {
 	Group
-	Items Omitted `json:"items"`
+	Items json.RawMessage `json:"items"`
 }
 
 // Tips contains a count and groups of tips.
@@ -341,24 +355,24 @@ type TipGroup struct {
 
 // Tip is a foursquare tip on a venue.
 type Tip struct {
-	ID                    string  `json:"id"`
-	CreatedAt             int     `json:"createdAt"`
-	Text                  string  `json:"text"`
-	Type                  string  `json:"type"`
-	URL                   string  `json:"url"`
-	CanonicalURL          string  `json:"canonicalurl"`
-	Photo                 Photo   `json:"photo"`
-	PhotoURL              string  `json:"photoUrl"`
-	Flags                 Omitted `json:"flags"`
-	Likes                 Likes   `json:"likes"`
-	Like                  bool    `json:"like"`
-	LogView               bool    `json:"logView"`
-	Listed                Lists   `json:"listed"`
-	AgreeCount            int     `json:"agreeCount"`
-	DisagreeCount         int     `json:"disagreeCount"`
-	Todo                  Count   `json:"todo"`
-	User                  User    `json:"user"`
-	AuthorInteractionType string  `json:"authorInteractionType"`
+	ID                    string          `json:"id"`
+	CreatedAt             Timestamp       `json:"createdAt"`
+	Text                  string          `json:"text"`
+	Type                  string          `json:"type"`
+	URL                   string          `json:"url"`
+	CanonicalURL          string          `json:"canonicalurl"`
+	Photo                 Photo           `json:"photo"`
+	PhotoURL              string          `json:"photoUrl"`
+	Flags                 json.RawMessage `json:"flags"`
+	Likes                 Likes           `json:"likes"`
+	Like                  bool            `json:"like"`
+	LogView               bool            `json:"logView"`
+	Listed                Lists           `json:"listed"`
+	AgreeCount            int             `json:"agreeCount"`
+	DisagreeCount         int             `json:"disagreeCount"`
+	Todo                  Count           `json:"todo"`
+	User                  User            `json:"user"`
+	AuthorInteractionType string          `json:"authorInteractionType"`
 }
 
 // Listed contains a count and the grouped lists
@@ -387,8 +401,8 @@ type List struct {
 	Collaborative bool      `json:"collaborative"`
 	URL           string    `json:"url"`
 	CanonicalURL  string    `json:"canonicalUrl"`
-	CreatedAt     int       `json:"createdAt"`
-	UpdatedAt     int       `json:"updatedAt"`
+	CreatedAt     Timestamp `json:"createdAt"`
+	UpdatedAt     Timestamp `json:"updatedAt"`
 	Photo         Photo     `json:"photo"`
 	LogView       bool      `json:"logView"`
 	GuideType     string    `json:"guideType"`
@@ -406,10 +420,11 @@ type ListItems struct {
 // ListItem contains more information about a list.
 // https://developer.foursquare.com/docs/api/lists/details
 type ListItem struct {
-	ID        string `json:"id"`
-	CreatedAt int    `json:"createdAt"`
-	Tip       Tip    `json:"tip"`
-	Photo     Photo  `json:"photo"`
+	ID        string    `json:"id"`
+	CreatedAt Timestamp `json:"createdAt"`
+	Venue     *Venue    `json:"venue"`
+	Tip       Tip       `json:"tip"`
+	Photo     Photo     `json:"photo"`
 }
 
 // Phrase contains a phrase commonly seen with a venue's tips.
@@ -442,10 +457,10 @@ type Hours struct {
 
 // TimeFrame shows when a venue is open.
 type TimeFrame struct {
-	Days          string  `json:"days"`
-	IncludesToday bool    `json:"includesToday"`
-	Open          []Open  `json:"open"`
-	Segments      Omitted `json:"Segments"`
+	Days          string          `json:"days"`
+	IncludesToday bool            `json:"includesToday"`
+	Open          []Open          `json:"open"`
+	Segments      json.RawMessage `json:"segments"`
 }
 
 // Open contains how a timeframe would be written out.
@@ -456,14 +471,15 @@ type Open struct {
 
 // PageUpdates is on a Venue.
 type PageUpdates struct {
-	Count int     `json:"count"`
-	Items Omitted `json:"items"`
+	Count int             `json:"count"`
+	Items json.RawMessage `json:"items"`
 }
 
-// Inbox is on a Venue.
+// Inbox is on a Venue. It contains the page updates the acting
+// user has not seen yet.
 type Inbox struct {
-	Count int     `json:"count"`
-	Items Omitted `json:"items"`
+	Count int             `json:"count"`
+	Items json.RawMessage `json:"items"`
 }
 
 // Attributes contains Attribute associated with a venue.
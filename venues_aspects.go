@@ -29,15 +29,13 @@ type venuePhotoResp struct {
 // Photos gets photos for a venue
 // https://developer.foursquare.com/docs/api/venues/photos
 func (s *VenueService) Photos(params *VenuePhotosParams) (*PhotoGrouping, *http.Response, error) {
-	photos := new(venuePhotoResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(params.VenueID+"/photos").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, photos)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
-	return &photos.Photos, resp, relevantError(err, *response)
 
+	photos := new(venuePhotoResp)
+	resp, err := receive(s.sling.New().Get(params.VenueID+"/photos").QueryStruct(params), EndpointPhotos, photos, s.result)
+	return &photos.Photos, resp, err
 }
 
 type venueEventResp struct {
@@ -58,9 +56,9 @@ type Event struct {
 	Categories []Category `json:"categories"`
 	HereNow    HereNow    `json:"hereNow"`
 	AllDay     bool       `json:"allDay"`
-	StartAt    int64      `json:"startAt"`
-	EndAt      int64      `json:"endAt"`
-	Date       int64      `json:"date"`
+	StartAt    Timestamp  `json:"startAt"`
+	EndAt      Timestamp  `json:"endAt"`
+	Date       Timestamp  `json:"date"`
 	TimeZone   string     `json:"timeZone"`
 	Stats      Stats      `json:"stats"`
 	URL        string     `json:"url"`
@@ -70,14 +68,8 @@ type Event struct {
 // https://developer.foursquare.com/docs/api/venues/events
 func (s *VenueService) Events(id string) (*Events, *http.Response, error) {
 	events := new(venueEventResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/events").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, events)
-	}
-
-	return &events.Events, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/events"), EndpointEvents, events, s.result)
+	return &events.Events, resp, err
 }
 
 // VenueHoursResp is the response for the venue hours endpoint
@@ -112,14 +104,8 @@ type HoursOpen struct {
 // https://developer.foursquare.com/docs/api/venues/hours
 func (s *VenueService) Hours(id string) (*VenueHoursResp, *http.Response, error) {
 	hours := new(VenueHoursResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/hours").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, hours)
-	}
-
-	return hours, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/hours"), EndpointHours, hours, s.result)
+	return hours, resp, err
 }
 
 type venueLikesResp struct {
@@ -138,14 +124,8 @@ type LikesResp struct {
 // https://developer.foursquare.com/docs/api/venues/likes
 func (s *VenueService) Likes(id string) (*LikesResp, *http.Response, error) {
 	likes := new(venueLikesResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/likes").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, likes)
-	}
-
-	return &likes.Likes, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/likes"), EndpointLikes, likes, s.result)
+	return &likes.Likes, resp, err
 }
 
 type venueLinkResp struct {
@@ -175,14 +155,8 @@ type Provider struct {
 // https://developer.foursquare.com/docs/api/venues/links
 func (s *VenueService) Links(id string) (*Links, *http.Response, error) {
 	links := new(venueLinkResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/links").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, links)
-	}
-
-	return &links.Links, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/links"), EndpointLinks, links, s.result)
+	return &links.Links, resp, err
 }
 
 // ListedGroup are the group options on VenueService.Listed
@@ -208,15 +182,13 @@ type venueListedResp struct {
 // Listed returns the lists that this venue appears on
 // https://developer.foursquare.com/docs/api/venues/listed
 func (s *VenueService) Listed(params *VenueListedParams) (*Listed, *http.Response, error) {
-	lists := new(venueListedResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(params.VenueID+"/listed").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, lists)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return &lists.Lists, resp, relevantError(err, *response)
+	lists := new(venueListedResp)
+	resp, err := receive(s.sling.New().Get(params.VenueID+"/listed").QueryStruct(params), EndpointListed, lists, s.result)
+	return &lists.Lists, resp, err
 }
 
 type venueNextVenuesResp struct {
@@ -232,14 +204,8 @@ type nextVenues struct {
 // https://developer.foursquare.com/docs/api/venues/nextvenues
 func (s *VenueService) NextVenues(id string) ([]Venue, *http.Response, error) {
 	venues := new(venueNextVenuesResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/nextvenues").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, venues)
-	}
-
-	return venues.NextVenues.Items, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/nextvenues"), EndpointNextVenues, venues, s.result)
+	return venues.NextVenues.Items, resp, err
 }
 
 type venueMenuResp struct {
@@ -295,27 +261,21 @@ type SubEntries struct {
 
 // SubEntry are the Items on a SubEntry
 type SubEntry struct {
-	EntryID     string   `json:"entryId"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Prices      []string `json:"prices"`
-	Price       string   `json:"price"`
-	Options     Omitted  `json:"options"`
-	Additions   Omitted  `json:"additions"`
+	EntryID     string          `json:"entryId"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Prices      []string        `json:"prices"`
+	Price       string          `json:"price"`
+	Options     json.RawMessage `json:"options"`
+	Additions   json.RawMessage `json:"additions"`
 }
 
 // Menu returns menu information for a venue.
 // https://developer.foursquare.com/docs/api/venues/menu
 func (s *VenueService) Menu(id string) (*MenuResp, *http.Response, error) {
 	menuResp := new(venueMenuResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(id+"/menu").Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, menuResp)
-	}
-
-	return &menuResp.Menu, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get(id+"/menu"), EndpointMenu, menuResp, s.result)
+	return &menuResp.Menu, resp, err
 }
 
 // TipSort is the sort options on VenueService.Tips
@@ -348,13 +308,11 @@ type tipsResp struct {
 // Tips returns tips for a venue.
 // https://developer.foursquare.com/docs/api/venues/tips
 func (s *VenueService) Tips(params *VenueTipsParams) ([]Tip, *http.Response, error) {
-	tipResp := new(tipResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get(params.VenueID+"/tips").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, tipResp)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return tipResp.Tips.Items, resp, relevantError(err, *response)
+	tipResp := new(tipResp)
+	resp, err := receive(s.sling.New().Get(params.VenueID+"/tips").QueryStruct(params), EndpointTips, tipResp, s.result)
+	return tipResp.Tips.Items, resp, err
 }
@@ -1,7 +1,6 @@
 package foursquarego
 
 import (
-	"encoding/json"
 	"net/http"
 )
 
@@ -13,14 +12,8 @@ type categoriesResp struct {
 // https://developer.foursquare.com/docs/api/venues/categories
 func (s *VenueService) Categories() ([]Category, *http.Response, error) {
 	cats := new(categoriesResp)
-	response := new(Response)
-	resp, err := s.sling.New().Get("categories").Receive(response, response)
-
-	if err == nil {
-		json.Unmarshal(response.Response, cats)
-	}
-
-	return cats.Categories, resp, relevantError(err, *response)
+	resp, err := receive(s.sling.New().Get("categories"), EndpointCategories, cats, s.result)
+	return cats.Categories, resp, err
 }
 
 // SearchIntent are the intent options on VenueService.Search
@@ -34,9 +27,12 @@ const (
 	IntentMatch   SearchIntent = "match"
 )
 
-// VenueSearchParams are the parameters for the VenueService.Search
+// VenueSearchParams are the parameters for the VenueService.Search.
+// Point and Bounds take precedence over the LatLong, Sw and Ne strings.
+// Address, City, State, Zip and Phone are used by intent match.
 type VenueSearchParams struct {
 	LatLong          string       `url:"ll,omitempty"`
+	Point            *LatLong     `url:"ll,omitempty"`
 	Near             string       `url:"near,omitempty"`
 	LatLongAccuracy  int          `url:"llAcc,omitempty"`
 	Altitude         int          `url:"alt,omitempty"`
@@ -47,10 +43,16 @@ type VenueSearchParams struct {
 	Radius           int          `url:"radius,omitempty"`
 	Sw               string       `url:"sw,omitempty"`
 	Ne               string       `url:"ne,omitempty"`
+	Bounds           *Bounds      `url:"bounds,omitempty"`
 	CategoryID       []string     `url:"categoryId,omitempty"`
 	URL              string       `url:"url,omitempty"`
 	ProviderID       string       `url:"providerId,omitempty"`
 	LinkedID         int          `url:"linkedId,omitempty"`
+	Address          string       `url:"address,omitempty"`
+	City             string       `url:"city,omitempty"`
+	State            string       `url:"state,omitempty"`
+	Zip              string       `url:"zip,omitempty"`
+	Phone            string       `url:"phone,omitempty"`
 }
 
 type venueSearchResp struct {
@@ -60,29 +62,30 @@ type venueSearchResp struct {
 // Search returns a list of venues near the current location, optionally matching a search term.
 // https://developer.foursquare.com/docs/api/venues/search
 func (s *VenueService) Search(params *VenueSearchParams) ([]Venue, *http.Response, error) {
-	venues := new(venueSearchResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get("search").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, venues)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return venues.Venues, resp, relevantError(err, *response)
+	venues := new(venueSearchResp)
+	resp, err := receive(s.sling.New().Get("search").QueryStruct(params), EndpointSearch, venues, s.result)
+	return venues.Venues, resp, err
 }
 
-// VenueSuggestParams are the parementers for the VenueService.SuggestCompletion
+// VenueSuggestParams are the parementers for the VenueService.SuggestCompletion.
+// Point and Bounds take precedence over the LatLong, Sw and Ne strings.
 type VenueSuggestParams struct {
-	LatLong          string `url:"ll,omitempty"`
-	Near             string `url:"near,omitempty"`
-	LatLongAccuracy  int    `url:"llAcc,omitempty"`
-	Altitude         int    `url:"alt,omitempty"`
-	AltitudeAccuracy int    `url:"altAcc,omitempty"`
-	Query            string `url:"query,omitempty"`
-	Limit            int    `url:"limit,omitempty"`
-	Radius           int    `url:"radius,omitempty"`
-	Sw               string `url:"sw,omitempty"`
-	Ne               string `url:"ne,omitempty"`
+	LatLong          string   `url:"ll,omitempty"`
+	Point            *LatLong `url:"ll,omitempty"`
+	Near             string   `url:"near,omitempty"`
+	LatLongAccuracy  int      `url:"llAcc,omitempty"`
+	Altitude         int      `url:"alt,omitempty"`
+	AltitudeAccuracy int      `url:"altAcc,omitempty"`
+	Query            string   `url:"query,omitempty"`
+	Limit            int      `url:"limit,omitempty"`
+	Radius           int      `url:"radius,omitempty"`
+	Sw               string   `url:"sw,omitempty"`
+	Ne               string   `url:"ne,omitempty"`
+	Bounds           *Bounds  `url:"bounds,omitempty"`
 }
 
 // MiniVenue is a compact Venue
@@ -102,22 +105,22 @@ type venueSuggestResp struct {
 // SuggestCompletion returns a list of mini-venues partially matching the search term, near the location.
 // https://developer.foursquare.com/docs/api/venues/suggestcompletion
 func (s *VenueService) SuggestCompletion(params *VenueSuggestParams) ([]MiniVenue, *http.Response, error) {
-	venues := new(venueSuggestResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get("suggestCompletion").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, venues)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return venues.MiniVenues, resp, relevantError(err, *response)
+	venues := new(venueSuggestResp)
+	resp, err := receive(s.sling.New().Get("suggestCompletion").QueryStruct(params), EndpointSuggestCompletion, venues, s.result)
+	return venues.MiniVenues, resp, err
 }
 
-// VenueTrendingParams are the parameters for VenueService.Trending
+// VenueTrendingParams are the parameters for VenueService.Trending.
+// Point takes precedence over the LatLong string.
 type VenueTrendingParams struct {
-	LatLong string `url:"ll,omitempty"`
-	Limit   int    `url:"limit,omitempty"`
-	Radius  int    `url:"radius,omitempty"`
+	LatLong string   `url:"ll,omitempty"`
+	Point   *LatLong `url:"ll,omitempty"`
+	Limit   int      `url:"limit,omitempty"`
+	Radius  int      `url:"radius,omitempty"`
 }
 
 type venueTrendingResp struct {
@@ -127,15 +130,13 @@ type venueTrendingResp struct {
 // Trending returns a list of venues near the current location with the most people currently checked in.
 // https://developer.foursquare.com/docs/api/venues/trending
 func (s *VenueService) Trending(params *VenueTrendingParams) ([]Venue, *http.Response, error) {
-	venues := new(venueTrendingResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get("trending").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, venues)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return venues.Venues, resp, relevantError(err, *response)
+	venues := new(venueTrendingResp)
+	resp, err := receive(s.sling.New().Get("trending").QueryStruct(params), EndpointTrending, venues, s.result)
+	return venues.Venues, resp, err
 }
 
 // ExploreSection are the section options on VenueService.Explore
@@ -182,9 +183,11 @@ const (
 	TimeAny ExploreTime = "any"
 )
 
-// VenueExploreParams are the parameters for VenueService.Explore
+// VenueExploreParams are the parameters for VenueService.Explore.
+// Point takes precedence over the LatLong string.
 type VenueExploreParams struct {
 	LatLong          string         `url:"ll,omitempty"`
+	Point            *LatLong       `url:"ll,omitempty"`
 	Near             string         `url:"near,omitempty"`
 	LatLongAccuracy  int            `url:"llAcc,omitempty"`
 	Altitude         int            `url:"alt,omitempty"`
@@ -245,12 +248,6 @@ type SuggestedBounds struct {
 	Sw LatLong `json:"sw"`
 }
 
-// LatLong simple lat/long fields for SuggestedBounds
-type LatLong struct {
-	Lat float64 `json:"lat"`
-	Lng float64 `json:"lng"`
-}
-
 // Recommendation the groups field in VenueExploreResp
 type Recommendation struct {
 	Type  string      `json:"type"`
@@ -269,13 +266,11 @@ type Recommend struct {
 // Explore returns a list of recommended venues near the current location.
 // https://developer.foursquare.com/docs/api/venues/explore
 func (s *VenueService) Explore(params *VenueExploreParams) (*VenueExploreResp, *http.Response, error) {
-	exploreResponse := new(VenueExploreResp)
-	response := new(Response)
-
-	resp, err := s.sling.New().Get("explore").QueryStruct(params).Receive(response, response)
-	if err == nil {
-		json.Unmarshal(response.Response, exploreResponse)
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
 	}
 
-	return exploreResponse, resp, relevantError(err, *response)
+	exploreResponse := new(VenueExploreResp)
+	resp, err := receive(s.sling.New().Get("explore").QueryStruct(params), EndpointExplore, exploreResponse, s.result)
+	return exploreResponse, resp, err
 }
@@ -1,42 +1,74 @@
-package foursquarego
+package foursquarego_test
 
 import (
-	"fmt"
 	"io/ioutil"
 	"net/http"
-	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/peppage/foursquarego"
+	"github.com/peppage/foursquarego/foursquaretest"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestVenueService_Details(t *testing.T) {
-	httpClient, mux, server := testServer()
-	defer server.Close()
-
-	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
+// fixtureServer is a fake API serving the captured fixture in json/venues
+// for the endpoint, and recording the endpoint the client labels each
+// request with.
+type fixtureServer struct {
+	*foursquaretest.Server
+	labels []string
+}
 
-		// Open file with sample json
-		f, err := os.Open("./json/venues/details.json")
-		if err != nil {
-			fmt.Fprintf(w, "error: %s", err)
-		}
-		defer f.Close()
+func newFixtureServer(t *testing.T, endpoint, file string) (*fixtureServer, *foursquarego.Client) {
+	b, err := ioutil.ReadFile(filepath.Join("json", "venues", file))
+	if err != nil {
+		t.Fatal(err)
+	}
 
-		b, err := ioutil.ReadAll(f)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
+	server := &fixtureServer{Server: foursquaretest.NewServer()}
+	server.SetFixture(endpoint, b)
 
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
+	client := server.Client().Use(func(next http.RoundTripper) http.RoundTripper {
+		return foursquarego.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			server.labels = append(server.labels, foursquarego.Endpoint(req.Context()))
+			return next.RoundTrip(req)
+		})
 	})
+	return server, client
+}
+
+// assertRequest asserts the only request was a GET to the endpoint at path,
+// with the client credentials and exactly the params in want.
+func assertRequest(t *testing.T, server *fixtureServer, endpoint, path string, want map[string]string) {
+	t.Helper()
+
+	requests := server.Requests("")
+	if !assert.Len(t, requests, 1) {
+		return
+	}
+	req := requests[0]
+	assert.Equal(t, endpoint, req.Endpoint)
+	assert.Equal(t, path, req.Path)
+	assert.Equal(t, []string{endpoint}, server.labels)
+	assert.Equal(t, "GET", req.Method)
+
+	assert.NotEmpty(t, req.Query.Get("v"))
+	assert.Equal(t, "foursquare", req.Query.Get("m"))
+	assert.Equal(t, foursquaretest.ClientID, req.Query.Get("client_id"))
+	assert.Equal(t, foursquaretest.ClientSecret, req.Query.Get("client_secret"))
+	for key, value := range want {
+		assert.Equal(t, value, req.Query.Get(key), key)
+	}
+	assert.Len(t, req.Query, len(want)+4)
+}
+
+func TestVenueService_Details(t *testing.T) {
+	server, client := newFixtureServer(t, foursquarego.EndpointVenueDetails, "details.json")
+	defer server.Close()
 
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	venue, _, err := client.Venues.Details("5414d0a6498ea3d31a3c64cf")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointVenueDetails, "/v2/venues/5414d0a6498ea3d31a3c64cf", map[string]string{})
 
 	assert.Equal(t, "5414d0a6498ea3d31a3c64cf", venue.ID)
 	assert.Equal(t, "Threes Brewing", venue.Name)
@@ -130,8 +162,8 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, 2, venue.BeenHere.Count)
 	assert.Equal(t, 0, venue.BeenHere.UnconfirmedCount)
 	assert.Equal(t, true, venue.BeenHere.Marked)
-	assert.Equal(t, int64(1444526165), venue.BeenHere.LastVisitedAt)
-	assert.Equal(t, int64(1444536965), venue.BeenHere.LastCheckinExpiredAt)
+	assert.Equal(t, int64(1444526165), venue.BeenHere.LastVisitedAt.Unix())
+	assert.Equal(t, int64(1444536965), venue.BeenHere.LastCheckinExpiredAt.Unix())
 
 	assert.Equal(t, 735, venue.Photos.Count)
 	assert.Len(t, venue.Photos.Groups, 1)
@@ -140,7 +172,7 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, 735, venue.Photos.Groups[0].Count)
 	assert.Len(t, venue.Photos.Groups[0].Items, 6)
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", venue.Photos.Groups[0].Items[0].ID)
-	assert.Equal(t, 1419692815, venue.Photos.Groups[0].Items[0].CreatedAt)
+	assert.Equal(t, int64(1419692815), venue.Photos.Groups[0].Items[0].CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", venue.Photos.Groups[0].Items[0].Source.Name)
 	assert.Equal(t, "https://foursquare.com", venue.Photos.Groups[0].Items[0].Source.URL)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", venue.Photos.Groups[0].Items[0].Prefix)
@@ -181,12 +213,12 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, "Other people here", venue.HereNow.Groups[0].Name)
 	assert.Equal(t, 16, venue.HereNow.Groups[0].Count)
 
-	assert.Equal(t, int64(1410650278), venue.CreatedAt)
+	assert.Equal(t, int64(1410650278), venue.CreatedAt.Unix())
 
 	assert.Equal(t, 165, venue.Tips.Count)
 	assert.Len(t, venue.Tips.Groups, 4)
 	assert.Equal(t, "59b7f2dd829b0c4692f0b465", venue.Tips.Groups[2].Items[0].ID)
-	assert.Equal(t, 1505227485, venue.Tips.Groups[2].Items[0].CreatedAt)
+	assert.Equal(t, int64(1505227485), venue.Tips.Groups[2].Items[0].CreatedAt.Unix())
 	assert.Equal(t, "This Gowanus brewpub offers a lovely patio for enjoying its own crafted beers, local brews, and a full bar. Threes almost always has an exciting food pop-up going on, too.", venue.Tips.Groups[2].Items[0].Text)
 	assert.Equal(t, "user", venue.Tips.Groups[2].Items[0].Type)
 	assert.Equal(t, "https://ny.eater.com/maps/best-outdoor-bars-drinking-nyc", venue.Tips.Groups[2].Items[0].URL)
@@ -215,15 +247,15 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, false, venue.Listed.Groups[0].Items[0].Collaborative)
 	assert.Equal(t, "/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc", venue.Listed.Groups[0].Items[0].URL)
 	assert.Equal(t, "https://foursquare.com/foursquare/list/20-great-spots-for-a-summer-beer-in-nyc", venue.Listed.Groups[0].Items[0].CanonicalURL)
-	assert.Equal(t, 1467318051, venue.Listed.Groups[0].Items[0].CreatedAt)
-	assert.Equal(t, 1467401782, venue.Listed.Groups[0].Items[0].UpdatedAt)
+	assert.Equal(t, int64(1467318051), venue.Listed.Groups[0].Items[0].CreatedAt.Unix())
+	assert.Equal(t, int64(1467401782), venue.Listed.Groups[0].Items[0].UpdatedAt.Unix())
 	assert.Equal(t, true, venue.Listed.Groups[0].Items[0].LogView)
 	assert.Equal(t, "bestOf", venue.Listed.Groups[0].Items[0].GuideType)
 	assert.Equal(t, true, venue.Listed.Groups[0].Items[0].Guide)
 	assert.Equal(t, 98, venue.Listed.Groups[0].Items[0].Followers.Count)
 	assert.Len(t, venue.Listed.Groups[0].Items[0].ListItems.Items, 1)
 	assert.Equal(t, "t5692caa3498efc71821e8c54", venue.Listed.Groups[0].Items[0].ListItems.Items[0].ID)
-	assert.Equal(t, 1467319289, venue.Listed.Groups[0].Items[0].ListItems.Items[0].CreatedAt)
+	assert.Equal(t, int64(1467319289), venue.Listed.Groups[0].Items[0].ListItems.Items[0].CreatedAt.Unix())
 
 	assert.Len(t, venue.Phrases, 3)
 	assert.Equal(t, "rotating kitchen", venue.Phrases[0].Phrase)
@@ -256,7 +288,7 @@ func TestVenueService_Details(t *testing.T) {
 	assert.Equal(t, 2, venue.Attributes.Groups[0].Items[0].PriceTier)
 
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", venue.BestPhoto.ID)
-	assert.Equal(t, 1419692815, venue.BestPhoto.CreatedAt)
+	assert.Equal(t, int64(1419692815), venue.BestPhoto.CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", venue.BestPhoto.Source.Name)
 	assert.Equal(t, "https://foursquare.com", venue.BestPhoto.Source.URL)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", venue.BestPhoto.Prefix)
@@ -271,38 +303,18 @@ func TestVenueService_Details(t *testing.T) {
 }
 
 func TestVenueService_Photos(t *testing.T) {
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointPhotos, "photos.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/5414d0a6498ea3d31a3c64cf/photos", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		// Open file with sample json
-		f, err := os.Open("./json/venues/photos.json")
-		if err != nil {
-			fmt.Fprintf(w, "error: %s", err)
-		}
-		defer f.Close()
-
-		b, err := ioutil.ReadAll(f)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	photos, _, err := client.Venues.Photos(&VenuePhotosParams{
+	photos, _, err := client.Venues.Photos(&foursquarego.VenuePhotosParams{
 		VenueID: "5414d0a6498ea3d31a3c64cf",
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointPhotos, "/v2/venues/5414d0a6498ea3d31a3c64cf/photos", map[string]string{})
 
 	assert.Equal(t, 30, photos.Count)
 	assert.Equal(t, "549ecb0f11d2ed4887ba35ab", photos.Items[0].ID)
-	assert.Equal(t, 1419692815, photos.Items[0].CreatedAt)
+	assert.Equal(t, int64(1419692815), photos.Items[0].CreatedAt.Unix())
 	assert.Equal(t, "Foursquare Web", photos.Items[0].Source.Name)
 	assert.Equal(t, "https://igx.4sqi.net/img/general/", photos.Items[0].Prefix)
 	assert.Equal(t, "/95760005_78vNYkB4sZbQ23LykVYIccyi2zSkD98qo3CHkQ-vI5k.jpg", photos.Items[0].Suffix)
@@ -321,35 +333,21 @@ func TestVenueService_Photos(t *testing.T) {
 }
 
 func TestVenueService_Events(t *testing.T) {
-	const filePath = "./json/venues/events.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointEvents, "events.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/40afe980f964a5203bf31ee3/events", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	events, _, err := client.Venues.Events("40afe980f964a5203bf31ee3")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointEvents, "/v2/venues/40afe980f964a5203bf31ee3/events", map[string]string{})
 
 	assert.Equal(t, 26, events.Count)
 	assert.Equal(t, "26 movies", events.Summary)
 	assert.Equal(t, "580850f7d67c37ceeeaae676", events.Items[0].ID)
 	assert.Equal(t, "Moonlight", events.Items[0].Name)
 	assert.Equal(t, true, events.Items[0].AllDay)
-	assert.Equal(t, int64(1526675883), events.Items[0].StartAt)
-	assert.Equal(t, int64(1526848682), events.Items[0].EndAt)
-	assert.Equal(t, int64(1477540800), events.Items[0].Date)
+	assert.Equal(t, int64(1526675883), events.Items[0].StartAt.Unix())
+	assert.Equal(t, int64(1526848682), events.Items[0].EndAt.Unix())
+	assert.Equal(t, int64(1477540800), events.Items[0].Date.Unix())
 	assert.Equal(t, "America/New_York", events.Items[0].TimeZone)
 	assert.Equal(t, 81, events.Items[0].Stats.CheckinsCount)
 	assert.Equal(t, 78, events.Items[0].Stats.UsersCount)
@@ -357,26 +355,12 @@ func TestVenueService_Events(t *testing.T) {
 }
 
 func TestVenueService_Hours(t *testing.T) {
-	const filePath = "./json/venues/hours.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointHours, "hours.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/40a55d80f964a52020f31ee3/hours", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	hours, _, err := client.Venues.Hours("40a55d80f964a52020f31ee3")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointHours, "/v2/venues/40a55d80f964a52020f31ee3/hours", map[string]string{})
 
 	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, hours.Hours.TimeFrames[0].Days)
 	assert.Equal(t, true, hours.Hours.TimeFrames[0].IncludesToday)
@@ -385,26 +369,12 @@ func TestVenueService_Hours(t *testing.T) {
 }
 
 func TestVenueService_Likes(t *testing.T) {
-	const filePath = "./json/venues/likes.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointLikes, "likes.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/40a55d80f964a52020f31ee3/likes", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	likes, _, err := client.Venues.Likes("40a55d80f964a52020f31ee3")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointLikes, "/v2/venues/40a55d80f964a52020f31ee3/likes", map[string]string{})
 
 	assert.Equal(t, 1077, likes.Count)
 	assert.Equal(t, "1077 Likes", likes.Summary)
@@ -419,26 +389,12 @@ func TestVenueService_Likes(t *testing.T) {
 }
 
 func TestVenueservice_Links(t *testing.T) {
-	const filePath = "./json/venues/links.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointLinks, "links.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/3fd66200f964a52074e31ee3/links", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	links, _, err := client.Venues.Links("3fd66200f964a52074e31ee3")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointLinks, "/v2/venues/3fd66200f964a52074e31ee3/links", map[string]string{})
 
 	assert.Equal(t, 11, links.Count)
 	assert.Equal(t, "nyt", links.Items[0].Provider.ID)
@@ -447,26 +403,12 @@ func TestVenueservice_Links(t *testing.T) {
 }
 
 func TestVenueService_Categories(t *testing.T) {
-	const filePath = "./json/venues/categories.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointCategories, "categories.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/categories", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	categories, _, err := client.Venues.Categories()
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointCategories, "/v2/venues/categories", map[string]string{})
 
 	assert.Equal(t, "4d4b7104d754a06370d81259", categories[0].ID)
 	assert.Equal(t, "Arts & Entertainment", categories[0].Name)
@@ -483,32 +425,18 @@ func TestVenueService_Categories(t *testing.T) {
 }
 
 func TestVenueService_Search(t *testing.T) {
-	const filePath = "./json/venues/search.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointSearch, "search.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/search", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"ll":    "40.7,-74",
-			"query": "singlecut",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	venues, _, err := client.Venues.Search(&VenueSearchParams{
+	venues, _, err := client.Venues.Search(&foursquarego.VenueSearchParams{
 		LatLong: "40.7,-74",
 		Query:   "singlecut",
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointSearch, "/v2/venues/search", map[string]string{
+		"ll":    "40.7,-74",
+		"query": "singlecut",
+	})
 
 	assert.Equal(t, "4f68de6bd5fbee32e5f4f3a5", venues[0].ID)
 	assert.Equal(t, false, venues[0].HasPerk)
@@ -516,31 +444,17 @@ func TestVenueService_Search(t *testing.T) {
 }
 
 func TestVenueService_Listed(t *testing.T) {
-	const filePath = "./json/venues/listed.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointListed, "listed.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/4f68de6bd5fbee32e5f4f3a5/listed", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"limit": "1",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	lists, _, err := client.Venues.Listed(&VenueListedParams{
+	lists, _, err := client.Venues.Listed(&foursquarego.VenueListedParams{
 		VenueID: "4f68de6bd5fbee32e5f4f3a5",
 		Limit:   1,
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointListed, "/v2/venues/4f68de6bd5fbee32e5f4f3a5/listed", map[string]string{
+		"limit": "1",
+	})
 
 	assert.Equal(t, 382, lists.Count)
 	assert.Equal(t, "others", lists.Groups[0].Type)
@@ -556,32 +470,18 @@ func TestVenueService_Listed(t *testing.T) {
 }
 
 func TestVenueService_SuggestCompletion(t *testing.T) {
-	const filePath = "./json/venues/suggest.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointSuggestCompletion, "suggest.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/suggestCompletion", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"ll":    "40.7,-74",
-			"query": "foursqu",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	venues, _, err := client.Venues.SuggestCompletion(&VenueSuggestParams{
+	venues, _, err := client.Venues.SuggestCompletion(&foursquarego.VenueSuggestParams{
 		LatLong: "40.7,-74",
 		Query:   "foursqu",
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointSuggestCompletion, "/v2/venues/suggestCompletion", map[string]string{
+		"ll":    "40.7,-74",
+		"query": "foursqu",
+	})
 
 	assert.Equal(t, "5a187743ccad6b307315e6fe", venues[0].ID)
 	assert.Equal(t, "Foursquare HQ", venues[0].Name)
@@ -589,32 +489,18 @@ func TestVenueService_SuggestCompletion(t *testing.T) {
 }
 
 func TestVenueService_Trending(t *testing.T) {
-	const filePath = "./json/venues/trending.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointTrending, "trending.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/trending", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"ll":    "40.7,-74",
-			"limit": "2",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	venues, _, err := client.Venues.Trending(&VenueTrendingParams{
+	venues, _, err := client.Venues.Trending(&foursquarego.VenueTrendingParams{
 		LatLong: "40.7,-74",
 		Limit:   2,
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointTrending, "/v2/venues/trending", map[string]string{
+		"ll":    "40.7,-74",
+		"limit": "2",
+	})
 
 	assert.Equal(t, "4eb90d85722e09311d356915", venues[0].ID)
 	assert.Equal(t, "World Trade Center Transportation Hub (The Oculus)", venues[0].Name)
@@ -622,50 +508,24 @@ func TestVenueService_Trending(t *testing.T) {
 }
 
 func TestVenueService_NextVenues(t *testing.T) {
-	const filePath = "./json/venues/nextvenues.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointNextVenues, "nextvenues.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/40a55d80f964a52020f31ee3/nextvenues", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	venues, _, err := client.Venues.NextVenues("40a55d80f964a52020f31ee3")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointNextVenues, "/v2/venues/40a55d80f964a52020f31ee3/nextvenues", map[string]string{})
 
 	assert.Len(t, venues, 5)
 	assert.Equal(t, "4acbe67af964a52044c820e3", venues[0].ID)
 }
 
 func TestVenueService_Menu(t *testing.T) {
-	const filePath = "./json/venues/menu.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointMenu, "menu.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/4fa89bb2e4b0bad89524b84a/menu", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
 	resp, _, err := client.Venues.Menu("4fa89bb2e4b0bad89524b84a")
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointMenu, "/v2/venues/4fa89bb2e4b0bad89524b84a/menu", map[string]string{})
 
 	assert.Len(t, resp.Menus.Items, 1)
 	assert.Equal(t, "singleplatform", resp.Provider.Name)
@@ -687,32 +547,18 @@ func TestVenueService_Menu(t *testing.T) {
 }
 
 func TestVenueService_Explore(t *testing.T) {
-	const filePath = "./json/venues/explore.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointExplore, "explore.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/explore", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"ll":    "40.76502,-73.97999",
-			"limit": "3",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	resp, _, err := client.Venues.Explore(&VenueExploreParams{
+	resp, _, err := client.Venues.Explore(&foursquarego.VenueExploreParams{
 		LatLong: "40.76502,-73.97999",
 		Limit:   3,
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointExplore, "/v2/venues/explore", map[string]string{
+		"ll":    "40.76502,-73.97999",
+		"limit": "3",
+	})
 
 	assert.Equal(t, "Tap to show:", resp.SuggestedFilters.Header)
 	assert.Len(t, resp.SuggestedFilters.Filters, 2)
@@ -744,34 +590,74 @@ func TestVenueService_Explore(t *testing.T) {
 }
 
 func TestVenueService_Tips(t *testing.T) {
-	const filePath = "./json/venues/tips.json"
-	httpClient, mux, server := testServer()
+	server, client := newFixtureServer(t, foursquarego.EndpointTips, "tips.json")
 	defer server.Close()
 
-	mux.HandleFunc("/v2/venues/5557c94e498ebde0672e57f4/tips", func(w http.ResponseWriter, r *http.Request) {
-		assertMethod(t, "GET", r)
-		assertQueryNoUser(t, map[string]string{
-			"sort":  "recent",
-			"limit": "1",
-		}, r)
-
-		b, err := getTestFile(filePath)
-		if err != nil {
-			t.Fatalf("Failed to open testfile %s", filePath)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(b)
-	})
-
-	client := NewClient(httpClient, "foursquare", clientID, clientSecret, "")
-	resp, _, err := client.Venues.Tips(&VenueTipsParams{
+	resp, _, err := client.Venues.Tips(&foursquarego.VenueTipsParams{
 		VenueID: "5557c94e498ebde0672e57f4",
-		Sort:    SortTipRecent,
+		Sort:    foursquarego.SortTipRecent,
 		Limit:   1,
 	})
 	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointTips, "/v2/venues/5557c94e498ebde0672e57f4/tips", map[string]string{
+		"sort":  "recent",
+		"limit": "1",
+	})
 
 	assert.Len(t, resp, 1)
 	assert.Equal(t, "57f1673c498e128bfb537f04", resp[0].ID)
 }
+
+func TestVenueService_WithResult(t *testing.T) {
+	server, client := newFixtureServer(t, foursquarego.EndpointHours, "hours.json")
+	defer server.Close()
+
+	server.SetRateLimit(5000, 5000)
+
+	var result foursquarego.Result
+	_, resp, err := client.Venues.WithResult(&result).Hours("40a55d80f964a52020f31ee3")
+	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointHours, "/v2/venues/40a55d80f964a52020f31ee3/hours", map[string]string{})
+
+	assert.Equal(t, 200, result.Meta.Code)
+	assert.NotEmpty(t, result.Meta.RequestID)
+	assert.Len(t, result.Notifications, 1)
+	assert.Equal(t, "notificationTray", result.Notifications[0].Type)
+	assert.Equal(t, 5, result.Notifications[0].Item.(*foursquarego.NotificationTray).UnreadCount)
+	assert.Equal(t, 5000, result.RateLimit.Limit)
+	assert.Equal(t, 4999, result.RateLimit.Remaining)
+	assert.Equal(t, resp, result.Response)
+}
+
+func TestVenue_PopulatedFields(t *testing.T) {
+	server, client := newFixtureServer(t, foursquarego.EndpointVenueDetails, "details_populated_synthetic.json")
+	defer server.Close()
+
+	venue, _, err := client.Venues.Details("4b1c3bd2f964a5206f0424e3")
+	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointVenueDetails, "/v2/venues/4b1c3bd2f964a5206f0424e3", map[string]string{})
+
+	// These fields are only in hand-written fixtures so they are kept raw.
+	assert.JSONEq(t, `[{"id": "556f676fbd6a75a99038d8ec"}]`, string(venue.VenueChains))
+	assert.Contains(t, string(venue.Specials), `"Check in 3 times for a free pastry"`)
+	assert.Contains(t, string(venue.HereNow.Groups[0].Items), `"Morning fuel"`)
+	assert.JSONEq(t, `["spam"]`, string(venue.Tips.Groups[0].Items[0].Flags))
+	assert.JSONEq(t, `[{"label": "Happy Hour", "renderedTime": "3:00 PM–5:00 PM"}]`, string(venue.Hours.Timeframes[0].Segments))
+	assert.Equal(t, 1, venue.PageUpates.Count)
+	assert.Contains(t, string(venue.PageUpates.Items), `"Our summer drinks are back!"`)
+	assert.Equal(t, 1, venue.Inbox.Count)
+	assert.Contains(t, string(venue.Inbox.Items), `"5ae8b4f5a4b5c3002c0f1e21"`)
+}
+
+func TestVenueService_MenuOptions(t *testing.T) {
+	server, client := newFixtureServer(t, foursquarego.EndpointMenu, "menu_options_synthetic.json")
+	defer server.Close()
+
+	resp, _, err := client.Venues.Menu("4a37a5eef964a520019f1fe3")
+	assert.Nil(t, err)
+	assertRequest(t, server, foursquarego.EndpointMenu, "/v2/venues/4a37a5eef964a520019f1fe3/menu", map[string]string{})
+
+	entry := resp.Menus.Items[0].Entries.Items[0].Entries.Items[0]
+	assert.Contains(t, string(entry.Options), `"Whole Pie"`)
+	assert.Contains(t, string(entry.Additions), `"Toppings"`)
+}
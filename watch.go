@@ -0,0 +1,183 @@
+package foursquarego
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WatchEvent is sent by Watcher when a venue changed or could not be
+// fetched.
+type WatchEvent struct {
+	VenueID string
+	// Venue is the venue as it is now, nil if Err is set.
+	Venue   *Venue
+	Changes []Change
+	Err     error
+	Time    time.Time
+}
+
+// Watcher re-fetches a set of venues with Details and reports what
+// changed since the last fetch.
+//
+//	w := foursquarego.NewWatcher(client.Venues, time.Hour)
+//	w.Seed(localCopy...)
+//	events := make(chan foursquarego.WatchEvent)
+//	go w.Run(ctx, events)
+//	for e := range events { ... }
+type Watcher struct {
+	venues   VenueAPI
+	interval time.Duration
+
+	mu    sync.Mutex
+	ids   []string
+	known map[string]*Venue
+	// seeded are the venues whose known copy came from Seed and has not
+	// been compared yet.
+	seeded map[string]bool
+}
+
+// defaultWatchInterval is used by NewWatcher for an interval that is not
+// positive.
+const defaultWatchInterval = time.Hour
+
+// NewWatcher creates a Watcher fetching with venues every interval, or
+// every hour if interval is not positive.
+func NewWatcher(venues VenueAPI, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	return &Watcher{
+		venues:   venues,
+		interval: interval,
+		known:    map[string]*Venue{},
+		seeded:   map[string]bool{},
+	}
+}
+
+// Add watches the venues. Their first fetch only records them, changes are
+// reported from the second fetch on.
+func (w *Watcher) Add(ids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := w.known[id]; !ok {
+			w.ids = append(w.ids, id)
+			w.known[id] = nil
+		}
+	}
+}
+
+// Seed watches the venues and compares the first fetch against them, use
+// it to start from a stored copy. A copy without photos, such as a search
+// result, does not have its photos compared so they are not all reported
+// as added.
+func (w *Watcher) Seed(venues ...Venue) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i := range venues {
+		v := venues[i]
+		if _, ok := w.known[v.ID]; !ok {
+			w.ids = append(w.ids, v.ID)
+		}
+		w.known[v.ID] = &v
+		w.seeded[v.ID] = true
+	}
+}
+
+// Remove stops watching the venues.
+func (w *Watcher) Remove(ids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, id := range ids {
+		delete(w.known, id)
+		delete(w.seeded, id)
+	}
+	kept := w.ids[:0]
+	for _, id := range w.ids {
+		if _, ok := w.known[id]; ok {
+			kept = append(kept, id)
+		}
+	}
+	w.ids = kept
+}
+
+// Poll fetches every venue once and returns an event for each that
+// changed or failed. It stops early when ctx is done and returns the
+// events so far.
+func (w *Watcher) Poll(ctx context.Context) []WatchEvent {
+	w.mu.Lock()
+	ids := append([]string(nil), w.ids...)
+	w.mu.Unlock()
+
+	var events []WatchEvent
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		venue, _, err := w.venues.Details(id)
+		now := time.Now()
+		if err != nil {
+			events = append(events, WatchEvent{VenueID: id, Err: err, Time: now})
+			continue
+		}
+
+		w.mu.Lock()
+		previous, ok := w.known[id]
+		seeded := w.seeded[id]
+		if ok {
+			w.known[id] = venue
+			delete(w.seeded, id)
+		}
+		w.mu.Unlock()
+
+		if previous == nil {
+			continue
+		}
+		changes := Diff(previous, venue)
+		if seeded && len(photoIDs(previous)) == 0 {
+			changes = withoutField(changes, ChangePhotos)
+		}
+		if len(changes) > 0 {
+			events = append(events, WatchEvent{VenueID: id, Venue: venue, Changes: changes, Time: now})
+		}
+	}
+	return events
+}
+
+// Run polls right away and then every interval, sending the events to
+// events until ctx is done. It closes events and returns ctx.Err().
+func (w *Watcher) Run(ctx context.Context, events chan<- WatchEvent) error {
+	defer close(events)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, e := range w.Poll(ctx) {
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// withoutField returns the changes other than those to field.
+func withoutField(changes []Change, field ChangeField) []Change {
+	var kept []Change
+	for _, c := range changes {
+		if c.Field != field {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}